@@ -0,0 +1,18 @@
+// common/errors.go
+package common
+
+import "errors"
+
+// Sentinel errors shared by every data structure so callers can tell failures apart with errors.Is.
+var (
+	// ErrEmpty is returned when removing an element from an empty data structure.
+	ErrEmpty = errors.New("data structure is empty")
+	// ErrInvalidItem is returned when an item is nil or empty and cannot be stored.
+	ErrInvalidItem = errors.New("element cannot be nil or empty")
+	// ErrFull is returned when adding an element to a bounded data structure that is at capacity.
+	ErrFull = errors.New("data structure is full")
+	// ErrClosed is returned when adding an element to, or waiting on, a closed data structure.
+	ErrClosed = errors.New("data structure is closed")
+	// ErrIndexOutOfRange is returned when an index is negative or past the end of an indexed data structure.
+	ErrIndexOutOfRange = errors.New("index out of range")
+)
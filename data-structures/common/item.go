@@ -14,3 +14,66 @@ type Item[T any] interface {
 
 // ItemFactory is a function type that creates a new item with the specified value.
 type ItemFactory[T any] func(value T) Item[T]
+
+// ValueItem is an Item holding any comparable value.
+// It is never empty, so every value, including the zero value, can be stored.
+type ValueItem[T comparable] struct {
+	value T
+}
+
+// NewValueItem creates a new ValueItem.
+func NewValueItem[T comparable](value T) ValueItem[T] {
+	return ValueItem[T]{value: value}
+}
+
+// Equals checks if two items have the same value.
+func (v ValueItem[T]) Equals(item Item[T]) bool {
+	return v.value == item.Value()
+}
+
+// Value returns the value of the ValueItem.
+func (v ValueItem[T]) Value() T {
+	return v.value
+}
+
+// IsEmpty always returns false, since a ValueItem holds a value even when it is the zero value.
+func (v ValueItem[T]) IsEmpty() bool {
+	return false
+}
+
+// ValueItemFactory is an ItemFactory for ValueItem; pass ValueItemFactory[T] where an ItemFactory[T] is expected.
+func ValueItemFactory[T comparable](value T) Item[T] {
+	return NewValueItem(value)
+}
+
+// NonZeroItem is an Item holding any comparable value that is empty when it holds the zero value,
+// like the string and int items that treat "" and 0 as empty.
+type NonZeroItem[T comparable] struct {
+	value T
+}
+
+// NewNonZeroItem creates a new NonZeroItem.
+func NewNonZeroItem[T comparable](value T) NonZeroItem[T] {
+	return NonZeroItem[T]{value: value}
+}
+
+// Equals checks if two items have the same value.
+func (n NonZeroItem[T]) Equals(item Item[T]) bool {
+	return n.value == item.Value()
+}
+
+// Value returns the value of the NonZeroItem.
+func (n NonZeroItem[T]) Value() T {
+	return n.value
+}
+
+// IsEmpty checks if the NonZeroItem holds the zero value.
+func (n NonZeroItem[T]) IsEmpty() bool {
+	var zero T
+	return n.value == zero
+}
+
+// NonZeroItemFactory is an ItemFactory for NonZeroItem; pass NonZeroItemFactory[T] where an ItemFactory[T] is expected.
+func NonZeroItemFactory[T comparable](value T) Item[T] {
+	return NewNonZeroItem(value)
+}
@@ -0,0 +1,214 @@
+package deque
+
+import (
+	"iter"
+
+	"github.com/sosalejandro/algo-practice/data-structures/common"
+)
+
+// minCapacity is the smallest backing array a deque keeps around.
+const minCapacity = 16
+
+// Deque represents a generic double-ended queue that holds elements of any type implementing common.Item.
+// Elements can be pushed and popped at both ends in amortized O(1), so a Deque can serve as either
+// a stack or a queue. Elements are stored in a ring buffer that grows when it is full and shrinks
+// again when occupancy drops to a quarter.
+type Deque[T any, I common.Item[T]] struct {
+	elements []I
+	head     int
+	size     int
+}
+
+// NewDeque creates a new instance of Deque.
+func NewDeque[T any, I common.Item[T]]() *Deque[T, I] {
+	return &Deque[T, I]{
+		elements: make([]I, minCapacity),
+	}
+}
+
+// PushFront adds an element to the front of the deque.
+// It returns common.ErrInvalidItem if the element is nil or empty.
+func (d *Deque[T, I]) PushFront(element I) error {
+	if element.IsEmpty() {
+		return common.ErrInvalidItem
+	}
+	d.grow()
+
+	d.head = (d.head - 1 + len(d.elements)) % len(d.elements)
+	d.elements[d.head] = element
+	d.size++
+	return nil
+}
+
+// PushBack adds an element to the back of the deque.
+// It returns common.ErrInvalidItem if the element is nil or empty.
+func (d *Deque[T, I]) PushBack(element I) error {
+	if element.IsEmpty() {
+		return common.ErrInvalidItem
+	}
+	d.grow()
+
+	d.elements[d.index(d.size)] = element
+	d.size++
+	return nil
+}
+
+// PopFront removes and returns the element at the front of the deque.
+// It returns common.ErrEmpty if the deque is empty.
+func (d *Deque[T, I]) PopFront() (I, error) {
+	var zero I
+	if d.size == 0 {
+		return zero, common.ErrEmpty
+	}
+
+	element := d.elements[d.head]
+	d.elements[d.head] = zero
+	d.head = d.index(1)
+	d.size--
+	d.shrink()
+	return element, nil
+}
+
+// PopBack removes and returns the element at the back of the deque.
+// It returns common.ErrEmpty if the deque is empty.
+func (d *Deque[T, I]) PopBack() (I, error) {
+	var zero I
+	if d.size == 0 {
+		return zero, common.ErrEmpty
+	}
+
+	last := d.index(d.size - 1)
+	element := d.elements[last]
+	d.elements[last] = zero
+	d.size--
+	d.shrink()
+	return element, nil
+}
+
+// PeekFront returns the element at the front of the deque without removing it.
+func (d *Deque[T, I]) PeekFront() I {
+	var zero I
+	if d.size == 0 {
+		return zero
+	}
+
+	return d.elements[d.head]
+}
+
+// PeekBack returns the element at the back of the deque without removing it.
+func (d *Deque[T, I]) PeekBack() I {
+	var zero I
+	if d.size == 0 {
+		return zero
+	}
+
+	return d.elements[d.index(d.size-1)]
+}
+
+// IsEmpty checks if the deque is empty.
+func (d *Deque[T, I]) IsEmpty() bool {
+	return d.size == 0
+}
+
+// Size returns the number of elements in the deque.
+func (d *Deque[T, I]) Size() int {
+	return d.size
+}
+
+// Clear removes all elements from the deque.
+func (d *Deque[T, I]) Clear() {
+	d.elements = make([]I, minCapacity)
+	d.head = 0
+	d.size = 0
+}
+
+// ToSlice returns a slice containing all elements in the deque, from front to back.
+// The returned slice is a copy and does not share memory with the deque.
+func (d *Deque[T, I]) ToSlice() []I {
+	result := make([]I, d.size)
+	for i := range result {
+		result[i] = d.elements[d.index(i)]
+	}
+	return result
+}
+
+// Contains checks if the deque contains the specified element.
+// It returns true if the element is found in the deque, and false otherwise.
+func (d *Deque[T, I]) Contains(element I) bool {
+	if element.IsEmpty() {
+		return false
+	}
+
+	for i := 0; i < d.size; i++ {
+		if d.elements[d.index(i)].Equals(element) {
+			return true
+		}
+	}
+	return false
+}
+
+// All returns an iterator over the index and element pairs of the deque, from front to back.
+// The deque must not be modified while iterating.
+func (d *Deque[T, I]) All() iter.Seq2[int, I] {
+	return func(yield func(int, I) bool) {
+		for i := 0; i < d.size; i++ {
+			if !yield(i, d.elements[d.index(i)]) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over the index and element pairs of the deque, from back to front.
+// The deque must not be modified while iterating.
+func (d *Deque[T, I]) Backward() iter.Seq2[int, I] {
+	return func(yield func(int, I) bool) {
+		for i := d.size - 1; i >= 0; i-- {
+			if !yield(i, d.elements[d.index(i)]) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over the elements of the deque, from front to back.
+// The deque must not be modified while iterating.
+func (d *Deque[T, I]) Values() iter.Seq[I] {
+	return func(yield func(I) bool) {
+		for i := 0; i < d.size; i++ {
+			if !yield(d.elements[d.index(i)]) {
+				return
+			}
+		}
+	}
+}
+
+// index maps a position relative to the front of the deque to an index in the ring buffer.
+func (d *Deque[T, I]) index(position int) int {
+	return (d.head + position) % len(d.elements)
+}
+
+// grow doubles the backing array when it is full.
+func (d *Deque[T, I]) grow() {
+	if d.size == len(d.elements) {
+		d.resize(max(len(d.elements)*2, minCapacity))
+	}
+}
+
+// shrink halves the backing array once occupancy drops to a quarter.
+func (d *Deque[T, I]) shrink() {
+	if len(d.elements) > minCapacity && d.size <= len(d.elements)/4 {
+		d.resize(max(len(d.elements)/2, minCapacity))
+	}
+}
+
+// resize moves the elements into a new backing array of the given length,
+// placing the front of the deque at index zero.
+func (d *Deque[T, I]) resize(length int) {
+	elements := make([]I, length)
+	for i := 0; i < d.size; i++ {
+		elements[i] = d.elements[d.index(i)]
+	}
+	d.elements = elements
+	d.head = 0
+}
@@ -0,0 +1,308 @@
+package deque
+
+import (
+	"errors"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/sosalejandro/algo-practice/data-structures/common"
+)
+
+type testStringItem struct {
+	value string
+}
+
+func (t *testStringItem) Equals(other common.Item[string]) bool {
+	return t.value == other.Value()
+}
+
+func (t *testStringItem) Value() string {
+	return t.value
+}
+
+func (t *testStringItem) IsEmpty() bool {
+	return t.value == ""
+}
+
+func values(d *Deque[string, *testStringItem]) []string {
+	result := make([]string, 0)
+	for _, item := range d.All() {
+		result = append(result, item.Value())
+	}
+	return result
+}
+
+func TestDeque_Push(t *testing.T) {
+	tests := []struct {
+		name        string
+		front       []string
+		back        []string
+		expected    []string
+		expectFront string
+		expectBack  string
+	}{
+		{
+			name:     "Empty deque",
+			expected: []string{},
+		},
+		{
+			name:        "PushBack only",
+			back:        []string{"a", "b", "c"},
+			expected:    []string{"a", "b", "c"},
+			expectFront: "a",
+			expectBack:  "c",
+		},
+		{
+			name:        "PushFront only",
+			front:       []string{"a", "b", "c"},
+			expected:    []string{"c", "b", "a"},
+			expectFront: "c",
+			expectBack:  "a",
+		},
+		{
+			name:        "PushFront and PushBack",
+			front:       []string{"b", "a"},
+			back:        []string{"c", "d"},
+			expected:    []string{"a", "b", "c", "d"},
+			expectFront: "a",
+			expectBack:  "d",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := NewDeque[string, *testStringItem]()
+			for _, value := range tt.front {
+				if err := d.PushFront(&testStringItem{value: value}); err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+			}
+			for _, value := range tt.back {
+				if err := d.PushBack(&testStringItem{value: value}); err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+			}
+
+			if result := values(d); !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("expected: %v, got: %v", tt.expected, result)
+			}
+			if d.Size() != len(tt.expected) {
+				t.Errorf("expected size: %v, got: %v", len(tt.expected), d.Size())
+			}
+			if tt.expectFront != "" && d.PeekFront().Value() != tt.expectFront {
+				t.Errorf("expected front: %v, got: %v", tt.expectFront, d.PeekFront().Value())
+			}
+			if tt.expectBack != "" && d.PeekBack().Value() != tt.expectBack {
+				t.Errorf("expected back: %v, got: %v", tt.expectBack, d.PeekBack().Value())
+			}
+		})
+	}
+}
+
+func TestDeque_Pop(t *testing.T) {
+	tests := []struct {
+		name        string
+		initial     []string
+		popFront    int
+		popBack     int
+		expected    []string
+		expectError error
+	}{
+		{
+			name:        "PopFront from empty deque",
+			initial:     []string{},
+			popFront:    1,
+			expected:    []string{},
+			expectError: common.ErrEmpty,
+		},
+		{
+			name:        "PopBack from empty deque",
+			initial:     []string{},
+			popBack:     1,
+			expected:    []string{},
+			expectError: common.ErrEmpty,
+		},
+		{
+			name:     "PopFront from deque with elements",
+			initial:  []string{"a", "b", "c"},
+			popFront: 1,
+			expected: []string{"b", "c"},
+		},
+		{
+			name:     "PopBack from deque with elements",
+			initial:  []string{"a", "b", "c"},
+			popBack:  1,
+			expected: []string{"a", "b"},
+		},
+		{
+			name:     "Pop from both ends",
+			initial:  []string{"a", "b", "c", "d"},
+			popFront: 1,
+			popBack:  2,
+			expected: []string{"b"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := NewDeque[string, *testStringItem]()
+			for _, value := range tt.initial {
+				d.PushBack(&testStringItem{value: value})
+			}
+
+			var err error
+			for i := 0; i < tt.popFront && err == nil; i++ {
+				var item *testStringItem
+				item, err = d.PopFront()
+				if err == nil && item.Value() != tt.initial[i] {
+					t.Errorf("expected: %v, got: %v", tt.initial[i], item.Value())
+				}
+			}
+			for i := 0; i < tt.popBack && err == nil; i++ {
+				var item *testStringItem
+				item, err = d.PopBack()
+				if err == nil && item.Value() != tt.initial[len(tt.initial)-1-i] {
+					t.Errorf("expected: %v, got: %v", tt.initial[len(tt.initial)-1-i], item.Value())
+				}
+			}
+
+			if !errors.Is(err, tt.expectError) {
+				t.Errorf("expected error: %v, got: %v", tt.expectError, err)
+			}
+			if result := values(d); !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("expected: %v, got: %v", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestDeque_InvalidItem(t *testing.T) {
+	d := NewDeque[string, *testStringItem]()
+
+	if err := d.PushFront(&testStringItem{value: ""}); !errors.Is(err, common.ErrInvalidItem) {
+		t.Errorf("expected error: %v, got: %v", common.ErrInvalidItem, err)
+	}
+	if err := d.PushBack(&testStringItem{value: ""}); !errors.Is(err, common.ErrInvalidItem) {
+		t.Errorf("expected error: %v, got: %v", common.ErrInvalidItem, err)
+	}
+	if !d.IsEmpty() {
+		t.Errorf("expected deque to be empty, but it wasn't")
+	}
+}
+
+func TestDeque_GrowAndShrink(t *testing.T) {
+	d := NewDeque[string, *testStringItem]()
+	n := 10 * minCapacity
+
+	// Alternate ends so both head and tail wrap around the buffer while it grows.
+	expected := make([]string, 0, n)
+	for i := 1; i <= n; i++ {
+		item := &testStringItem{value: strconv.Itoa(i)}
+		if i%2 == 0 {
+			d.PushFront(item)
+			expected = append([]string{item.value}, expected...)
+		} else {
+			d.PushBack(item)
+			expected = append(expected, item.value)
+		}
+	}
+	if result := values(d); !reflect.DeepEqual(result, expected) {
+		t.Fatalf("expected: %v, got: %v", expected, result)
+	}
+
+	for i := 0; i < n-1; i++ {
+		var err error
+		if i%2 == 0 {
+			_, err = d.PopFront()
+			expected = expected[1:]
+		} else {
+			_, err = d.PopBack()
+			expected = expected[:len(expected)-1]
+		}
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	if result := values(d); !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected: %v, got: %v", expected, result)
+	}
+	if len(d.elements) != minCapacity {
+		t.Errorf("expected backing array of %v, got: %v", minCapacity, len(d.elements))
+	}
+}
+
+func TestDeque_Contains(t *testing.T) {
+	tests := []struct {
+		name     string
+		initial  []string
+		toCheck  string
+		expected bool
+	}{
+		{
+			name:     "Empty deque",
+			initial:  []string{},
+			toCheck:  "a",
+			expected: false,
+		},
+		{
+			name:     "Deque contains element",
+			initial:  []string{"a", "b"},
+			toCheck:  "b",
+			expected: true,
+		},
+		{
+			name:     "Deque does not contain element",
+			initial:  []string{"a", "b"},
+			toCheck:  "c",
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := NewDeque[string, *testStringItem]()
+			for _, value := range tt.initial {
+				d.PushBack(&testStringItem{value: value})
+			}
+
+			if result := d.Contains(&testStringItem{value: tt.toCheck}); result != tt.expected {
+				t.Errorf("expected: %v, got: %v", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestDeque_Iterators(t *testing.T) {
+	d := NewDeque[string, *testStringItem]()
+	for _, value := range []string{"b", "a"} {
+		d.PushFront(&testStringItem{value: value})
+	}
+	d.PushBack(&testStringItem{value: "c"})
+
+	forward := make([]string, 0)
+	for item := range d.Values() {
+		forward = append(forward, item.Value())
+	}
+	if expected := []string{"a", "b", "c"}; !reflect.DeepEqual(forward, expected) {
+		t.Errorf("expected: %v, got: %v", expected, forward)
+	}
+
+	backward := make([]string, 0)
+	for i, item := range d.Backward() {
+		if item.Value() != forward[i] {
+			t.Errorf("expected index %v to hold %v, got: %v", i, forward[i], item.Value())
+		}
+		backward = append(backward, item.Value())
+	}
+	if expected := []string{"c", "b", "a"}; !reflect.DeepEqual(backward, expected) {
+		t.Errorf("expected: %v, got: %v", expected, backward)
+	}
+
+	slice := d.ToSlice()
+	d.Clear()
+	if len(slice) != 3 || !d.IsEmpty() {
+		t.Errorf("expected ToSlice to survive Clear, got: %v", len(slice))
+	}
+}
@@ -0,0 +1,245 @@
+package linkedlist
+
+import (
+	"iter"
+
+	"github.com/sosalejandro/algo-practice/data-structures/common"
+)
+
+// DoublyNode is a node of a DoublyLinkedList. It links to both its successor and predecessor,
+// so it can be used as a handle to insert, remove or move values in O(1).
+type DoublyNode[T any] struct {
+	Value T
+	Next  *DoublyNode[T]
+	Prev  *DoublyNode[T]
+
+	// list is the list the node belongs to, or nil once it has been removed.
+	list *DoublyLinkedList[T]
+}
+
+// DoublyLinkedList is a linked list whose nodes link in both directions.
+// Unlike LinkedList, it removes from either end in O(1) and keeps track of its length.
+// Like LinkedList, value based operations use the equality function supplied by the constructor.
+type DoublyLinkedList[T any] struct {
+	Head *DoublyNode[T]
+	Tail *DoublyNode[T]
+	size int
+
+	equals func(a, b T) bool
+}
+
+// NewDoublyLinkedList creates a new, empty DoublyLinkedList that compares values with ==.
+func NewDoublyLinkedList[T comparable]() *DoublyLinkedList[T] {
+	return &DoublyLinkedList[T]{equals: func(a, b T) bool { return a == b }}
+}
+
+// NewDoublyLinkedListFunc creates a new, empty DoublyLinkedList that compares values with equals.
+func NewDoublyLinkedListFunc[T any](equals func(a, b T) bool) *DoublyLinkedList[T] {
+	return &DoublyLinkedList[T]{equals: equals}
+}
+
+// NewItemDoublyLinkedList creates a new, empty DoublyLinkedList of items that compares values with their Equals method.
+func NewItemDoublyLinkedList[T any, I common.Item[T]]() *DoublyLinkedList[I] {
+	return &DoublyLinkedList[I]{equals: itemEquals[T, I]}
+}
+
+// First returns the first node of the list, or nil if the list is empty.
+func (dl *DoublyLinkedList[T]) First() *DoublyNode[T] {
+	return dl.Head
+}
+
+// Last returns the last node of the list, or nil if the list is empty.
+func (dl *DoublyLinkedList[T]) Last() *DoublyNode[T] {
+	return dl.Tail
+}
+
+// Len returns the number of nodes in the list.
+func (dl *DoublyLinkedList[T]) Len() int {
+	return dl.size
+}
+
+// AddFirst inserts a value at the front of the list and returns its node.
+func (dl *DoublyLinkedList[T]) AddFirst(value T) *DoublyNode[T] {
+	return dl.insertBetween(&DoublyNode[T]{Value: value}, nil, dl.Head)
+}
+
+// AddLast inserts a value at the back of the list and returns its node.
+func (dl *DoublyLinkedList[T]) AddLast(value T) *DoublyNode[T] {
+	return dl.insertBetween(&DoublyNode[T]{Value: value}, dl.Tail, nil)
+}
+
+// InsertBefore inserts a value immediately before node and returns the new node.
+// It returns nil if node does not belong to the list.
+func (dl *DoublyLinkedList[T]) InsertBefore(node *DoublyNode[T], value T) *DoublyNode[T] {
+	if !dl.owns(node) {
+		return nil
+	}
+	return dl.insertBetween(&DoublyNode[T]{Value: value}, node.Prev, node)
+}
+
+// InsertAfter inserts a value immediately after node and returns the new node.
+// It returns nil if node does not belong to the list.
+func (dl *DoublyLinkedList[T]) InsertAfter(node *DoublyNode[T], value T) *DoublyNode[T] {
+	if !dl.owns(node) {
+		return nil
+	}
+	return dl.insertBetween(&DoublyNode[T]{Value: value}, node, node.Next)
+}
+
+// RemoveFirst removes and returns the first node of the list, or nil if the list is empty.
+func (dl *DoublyLinkedList[T]) RemoveFirst() *DoublyNode[T] {
+	if dl.Head == nil {
+		return nil
+	}
+	return dl.unlink(dl.Head)
+}
+
+// RemoveLast removes and returns the last node of the list, or nil if the list is empty.
+func (dl *DoublyLinkedList[T]) RemoveLast() *DoublyNode[T] {
+	if dl.Tail == nil {
+		return nil
+	}
+	return dl.unlink(dl.Tail)
+}
+
+// Remove removes node from the list.
+// It returns false if node does not belong to the list.
+func (dl *DoublyLinkedList[T]) Remove(node *DoublyNode[T]) bool {
+	if !dl.owns(node) {
+		return false
+	}
+	dl.unlink(node)
+	return true
+}
+
+// MoveToFront moves node to the front of the list.
+// It does nothing if node does not belong to the list.
+func (dl *DoublyLinkedList[T]) MoveToFront(node *DoublyNode[T]) {
+	if !dl.owns(node) || dl.Head == node {
+		return
+	}
+	dl.insertBetween(dl.unlink(node), nil, dl.Head)
+}
+
+// MoveToBack moves node to the back of the list.
+// It does nothing if node does not belong to the list.
+func (dl *DoublyLinkedList[T]) MoveToBack(node *DoublyNode[T]) {
+	if !dl.owns(node) || dl.Tail == node {
+		return
+	}
+	dl.insertBetween(dl.unlink(node), dl.Tail, nil)
+}
+
+// Find returns the first node holding value, or nil if the list does not contain it.
+// The node can be passed to Remove, InsertBefore and the other node-handle operations.
+func (dl *DoublyLinkedList[T]) Find(value T) *DoublyNode[T] {
+	for current := dl.Head; current != nil; current = current.Next {
+		if valuesEqual(dl.equals, current.Value, value) {
+			return current
+		}
+	}
+	return nil
+}
+
+// Contains checks if the list contains value.
+func (dl *DoublyLinkedList[T]) Contains(value T) bool {
+	return dl.Find(value) != nil
+}
+
+// IndexOf returns the index of the first node holding value, or -1 if the list does not contain it.
+func (dl *DoublyLinkedList[T]) IndexOf(value T) int {
+	i := 0
+	for current := dl.Head; current != nil; current = current.Next {
+		if valuesEqual(dl.equals, current.Value, value) {
+			return i
+		}
+		i++
+	}
+	return -1
+}
+
+// All returns an iterator over the index and value pairs of the list, from head to tail.
+// The list must not be modified while iterating.
+func (dl *DoublyLinkedList[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		i := 0
+		for current := dl.Head; current != nil; current = current.Next {
+			if !yield(i, current.Value) {
+				return
+			}
+			i++
+		}
+	}
+}
+
+// Backward returns an iterator over the index and value pairs of the list, from tail to head.
+// The list must not be modified while iterating.
+func (dl *DoublyLinkedList[T]) Backward() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		i := dl.size - 1
+		for current := dl.Tail; current != nil; current = current.Prev {
+			if !yield(i, current.Value) {
+				return
+			}
+			i--
+		}
+	}
+}
+
+// Values returns an iterator over the values of the list, from head to tail.
+// The list must not be modified while iterating.
+func (dl *DoublyLinkedList[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for current := dl.Head; current != nil; current = current.Next {
+			if !yield(current.Value) {
+				return
+			}
+		}
+	}
+}
+
+// owns checks if node is a live node of the list.
+func (dl *DoublyLinkedList[T]) owns(node *DoublyNode[T]) bool {
+	return node != nil && node.list == dl
+}
+
+// insertBetween links node between prev and next, either of which may be nil at the ends of the list.
+func (dl *DoublyLinkedList[T]) insertBetween(node, prev, next *DoublyNode[T]) *DoublyNode[T] {
+	node.Prev = prev
+	node.Next = next
+	node.list = dl
+
+	if prev == nil {
+		dl.Head = node
+	} else {
+		prev.Next = node
+	}
+	if next == nil {
+		dl.Tail = node
+	} else {
+		next.Prev = node
+	}
+
+	dl.size++
+	return node
+}
+
+// unlink detaches node from the list and returns it.
+func (dl *DoublyLinkedList[T]) unlink(node *DoublyNode[T]) *DoublyNode[T] {
+	if node.Prev == nil {
+		dl.Head = node.Next
+	} else {
+		node.Prev.Next = node.Next
+	}
+	if node.Next == nil {
+		dl.Tail = node.Prev
+	} else {
+		node.Next.Prev = node.Prev
+	}
+
+	node.Next = nil
+	node.Prev = nil
+	node.list = nil
+	dl.size--
+	return node
+}
@@ -0,0 +1,303 @@
+package linkedlist
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+)
+
+// checkDoublyLinkedList verifies that the list holds want in both directions and that Len matches.
+func checkDoublyLinkedList(t *testing.T, dl *DoublyLinkedList[int], want []int) {
+	t.Helper()
+
+	forward := make([]int, 0)
+	for value := range dl.Values() {
+		forward = append(forward, value)
+	}
+	if !reflect.DeepEqual(forward, want) {
+		t.Errorf("expected %v, got %v", want, forward)
+	}
+
+	backward := make([]int, 0)
+	for _, value := range dl.Backward() {
+		backward = append(backward, value)
+	}
+	reversed := slices.Clone(want)
+	slices.Reverse(reversed)
+	if !reflect.DeepEqual(backward, reversed) {
+		t.Errorf("expected backward %v, got %v", reversed, backward)
+	}
+
+	if dl.Len() != len(want) {
+		t.Errorf("expected length %v, got %v", len(want), dl.Len())
+	}
+	if dl.Head != nil && dl.Head.Prev != nil {
+		t.Error("expected Head to have no predecessor")
+	}
+	if dl.Tail != nil && dl.Tail.Next != nil {
+		t.Error("expected Tail to have no successor")
+	}
+}
+
+func TestDoublyLinkedList_AddAndRemoveEnds(t *testing.T) {
+	tests := []struct {
+		name      string
+		operation func(dl *DoublyLinkedList[int]) *DoublyNode[int]
+		initial   []int
+		wantNode  int
+		wantNil   bool
+		want      []int
+	}{
+		{
+			name:      "AddFirst to empty list",
+			initial:   []int{},
+			operation: func(dl *DoublyLinkedList[int]) *DoublyNode[int] { return dl.AddFirst(1) },
+			wantNode:  1,
+			want:      []int{1},
+		},
+		{
+			name:      "AddFirst to non-empty list",
+			initial:   []int{2, 3},
+			operation: func(dl *DoublyLinkedList[int]) *DoublyNode[int] { return dl.AddFirst(1) },
+			wantNode:  1,
+			want:      []int{1, 2, 3},
+		},
+		{
+			name:      "AddLast to non-empty list",
+			initial:   []int{1, 2},
+			operation: func(dl *DoublyLinkedList[int]) *DoublyNode[int] { return dl.AddLast(3) },
+			wantNode:  3,
+			want:      []int{1, 2, 3},
+		},
+		{
+			name:      "RemoveFirst from empty list",
+			initial:   []int{},
+			operation: func(dl *DoublyLinkedList[int]) *DoublyNode[int] { return dl.RemoveFirst() },
+			wantNil:   true,
+			want:      []int{},
+		},
+		{
+			name:      "RemoveFirst from non-empty list",
+			initial:   []int{1, 2, 3},
+			operation: func(dl *DoublyLinkedList[int]) *DoublyNode[int] { return dl.RemoveFirst() },
+			wantNode:  1,
+			want:      []int{2, 3},
+		},
+		{
+			name:      "RemoveLast from empty list",
+			initial:   []int{},
+			operation: func(dl *DoublyLinkedList[int]) *DoublyNode[int] { return dl.RemoveLast() },
+			wantNil:   true,
+			want:      []int{},
+		},
+		{
+			name:      "RemoveLast from single element list",
+			initial:   []int{1},
+			operation: func(dl *DoublyLinkedList[int]) *DoublyNode[int] { return dl.RemoveLast() },
+			wantNode:  1,
+			want:      []int{},
+		},
+		{
+			name:      "RemoveLast from non-empty list",
+			initial:   []int{1, 2, 3},
+			operation: func(dl *DoublyLinkedList[int]) *DoublyNode[int] { return dl.RemoveLast() },
+			wantNode:  3,
+			want:      []int{1, 2},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dl := NewDoublyLinkedList[int]()
+			for _, value := range tt.initial {
+				dl.AddLast(value)
+			}
+
+			node := tt.operation(dl)
+			if tt.wantNil {
+				if node != nil {
+					t.Errorf("expected nil node, got %v", node.Value)
+				}
+			} else if node == nil || node.Value != tt.wantNode {
+				t.Errorf("expected node %v, got %v", tt.wantNode, node)
+			}
+			checkDoublyLinkedList(t, dl, tt.want)
+		})
+	}
+}
+
+func TestDoublyLinkedList_NodeHandles(t *testing.T) {
+	tests := []struct {
+		name      string
+		initial   []int
+		handle    int
+		operation func(dl *DoublyLinkedList[int], node *DoublyNode[int])
+		want      []int
+	}{
+		{
+			name:      "InsertBefore head",
+			initial:   []int{2, 3},
+			handle:    0,
+			operation: func(dl *DoublyLinkedList[int], node *DoublyNode[int]) { dl.InsertBefore(node, 1) },
+			want:      []int{1, 2, 3},
+		},
+		{
+			name:      "InsertBefore middle",
+			initial:   []int{1, 3},
+			handle:    1,
+			operation: func(dl *DoublyLinkedList[int], node *DoublyNode[int]) { dl.InsertBefore(node, 2) },
+			want:      []int{1, 2, 3},
+		},
+		{
+			name:      "InsertAfter tail",
+			initial:   []int{1, 2},
+			handle:    1,
+			operation: func(dl *DoublyLinkedList[int], node *DoublyNode[int]) { dl.InsertAfter(node, 3) },
+			want:      []int{1, 2, 3},
+		},
+		{
+			name:      "InsertAfter middle",
+			initial:   []int{1, 3},
+			handle:    0,
+			operation: func(dl *DoublyLinkedList[int], node *DoublyNode[int]) { dl.InsertAfter(node, 2) },
+			want:      []int{1, 2, 3},
+		},
+		{
+			name:      "Remove head",
+			initial:   []int{1, 2, 3},
+			handle:    0,
+			operation: func(dl *DoublyLinkedList[int], node *DoublyNode[int]) { dl.Remove(node) },
+			want:      []int{2, 3},
+		},
+		{
+			name:      "Remove middle",
+			initial:   []int{1, 2, 3},
+			handle:    1,
+			operation: func(dl *DoublyLinkedList[int], node *DoublyNode[int]) { dl.Remove(node) },
+			want:      []int{1, 3},
+		},
+		{
+			name:      "Remove tail",
+			initial:   []int{1, 2, 3},
+			handle:    2,
+			operation: func(dl *DoublyLinkedList[int], node *DoublyNode[int]) { dl.Remove(node) },
+			want:      []int{1, 2},
+		},
+		{
+			name:    "Remove twice",
+			initial: []int{1, 2, 3},
+			handle:  1,
+			operation: func(dl *DoublyLinkedList[int], node *DoublyNode[int]) {
+				dl.Remove(node)
+				if dl.Remove(node) {
+					t.Error("expected removing a detached node to fail")
+				}
+			},
+			want: []int{1, 3},
+		},
+		{
+			name:      "MoveToFront tail",
+			initial:   []int{1, 2, 3},
+			handle:    2,
+			operation: func(dl *DoublyLinkedList[int], node *DoublyNode[int]) { dl.MoveToFront(node) },
+			want:      []int{3, 1, 2},
+		},
+		{
+			name:      "MoveToFront head",
+			initial:   []int{1, 2, 3},
+			handle:    0,
+			operation: func(dl *DoublyLinkedList[int], node *DoublyNode[int]) { dl.MoveToFront(node) },
+			want:      []int{1, 2, 3},
+		},
+		{
+			name:      "MoveToBack head",
+			initial:   []int{1, 2, 3},
+			handle:    0,
+			operation: func(dl *DoublyLinkedList[int], node *DoublyNode[int]) { dl.MoveToBack(node) },
+			want:      []int{2, 3, 1},
+		},
+		{
+			name:      "MoveToBack middle",
+			initial:   []int{1, 2, 3},
+			handle:    1,
+			operation: func(dl *DoublyLinkedList[int], node *DoublyNode[int]) { dl.MoveToBack(node) },
+			want:      []int{1, 3, 2},
+		},
+		{
+			name:    "Foreign node is ignored",
+			initial: []int{1, 2},
+			handle:  0,
+			operation: func(dl *DoublyLinkedList[int], node *DoublyNode[int]) {
+				other := NewDoublyLinkedList[int]()
+				foreign := other.AddLast(9)
+				if dl.InsertBefore(foreign, 0) != nil || dl.InsertAfter(foreign, 0) != nil {
+					t.Error("expected inserting next to a foreign node to fail")
+				}
+				if dl.Remove(foreign) {
+					t.Error("expected removing a foreign node to fail")
+				}
+				dl.MoveToFront(foreign)
+				dl.MoveToBack(foreign)
+				checkDoublyLinkedList(t, other, []int{9})
+			},
+			want: []int{1, 2},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dl := NewDoublyLinkedList[int]()
+			nodes := make([]*DoublyNode[int], 0, len(tt.initial))
+			for _, value := range tt.initial {
+				nodes = append(nodes, dl.AddLast(value))
+			}
+
+			tt.operation(dl, nodes[tt.handle])
+			checkDoublyLinkedList(t, dl, tt.want)
+		})
+	}
+}
+
+func TestDoublyLinkedList_Find(t *testing.T) {
+	tests := []struct {
+		name      string
+		initial   []int
+		value     int
+		wantIndex int
+		want      []int
+	}{
+		{
+			name:      "Find and remove middle",
+			initial:   []int{1, 2, 3},
+			value:     2,
+			wantIndex: 1,
+			want:      []int{1, 3},
+		},
+		{
+			name:      "Missing value",
+			initial:   []int{1, 2, 3},
+			value:     4,
+			wantIndex: -1,
+			want:      []int{1, 2, 3},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dl := NewDoublyLinkedList[int]()
+			for _, value := range tt.initial {
+				dl.AddLast(value)
+			}
+
+			if got := dl.IndexOf(tt.value); got != tt.wantIndex {
+				t.Errorf("expected index %v, got %v", tt.wantIndex, got)
+			}
+			if got := dl.Contains(tt.value); got != (tt.wantIndex >= 0) {
+				t.Errorf("expected contains %v, got %v", tt.wantIndex >= 0, got)
+			}
+
+			dl.Remove(dl.Find(tt.value))
+			checkDoublyLinkedList(t, dl, tt.want)
+		})
+	}
+}
@@ -1,21 +1,69 @@
 package linkedlist
 
+import (
+	"fmt"
+	"iter"
+	"reflect"
+
+	"github.com/sosalejandro/algo-practice/data-structures/common"
+)
+
+// NodeValue lists the scalar types lists used to be restricted to.
+//
+// Deprecated: lists accept values of any type; this constraint is no longer used.
 type NodeValue interface {
 	string | int | uint | float32 | float64 | int64 | uint64
 }
 
+// NodeArrayValue lists the slice types lists used to be restricted to.
+//
+// Deprecated: lists accept values of any type; this constraint is no longer used.
 type NodeArrayValue interface {
 	[]string | []int | []uint | []float32 | []float64 | []int64 | []uint64
 }
 
-type Node[T NodeValue | NodeArrayValue] struct {
+// Node is a node of a LinkedList.
+type Node[T any] struct {
 	Value T
 	Next  *Node[T]
 }
 
-type LinkedList[T NodeValue | NodeArrayValue] struct {
+// LinkedList is a singly linked list holding values of any type.
+// Value based operations such as Find and Remove compare values with the equality function
+// supplied by the constructor; the zero value falls back to reflect.DeepEqual.
+// The list keeps track of its length so index operations are bounds-checked in O(1);
+// Head and Tail should therefore only be modified through the list's methods.
+type LinkedList[T any] struct {
 	Head *Node[T]
 	Tail *Node[T]
+	size int
+
+	equals func(a, b T) bool
+}
+
+// NewLinkedList creates a new, empty LinkedList that compares values with ==.
+func NewLinkedList[T comparable]() *LinkedList[T] {
+	return &LinkedList[T]{equals: func(a, b T) bool { return a == b }}
+}
+
+// NewLinkedListFunc creates a new, empty LinkedList that compares values with equals.
+func NewLinkedListFunc[T any](equals func(a, b T) bool) *LinkedList[T] {
+	return &LinkedList[T]{equals: equals}
+}
+
+// FromSlice creates a new LinkedList holding values in order.
+// Like the zero value, the list compares values with reflect.DeepEqual.
+func FromSlice[T any](values []T) *LinkedList[T] {
+	ll := &LinkedList[T]{}
+	for _, value := range values {
+		ll.AddLast(value)
+	}
+	return ll
+}
+
+// NewItemLinkedList creates a new, empty LinkedList of items that compares values with their Equals method.
+func NewItemLinkedList[T any, I common.Item[T]]() *LinkedList[I] {
+	return &LinkedList[I]{equals: itemEquals[T, I]}
 }
 
 func (ll *LinkedList[T]) First() *Node[T] {
@@ -26,6 +74,11 @@ func (ll *LinkedList[T]) Last() *Node[T] {
 	return ll.Tail
 }
 
+// Len returns the number of nodes in the list.
+func (ll *LinkedList[T]) Len() int {
+	return ll.size
+}
+
 func (ll *LinkedList[T]) AddFirst(value T) {
 	newNode := &Node[T]{Value: value}
 	if ll.Head == nil {
@@ -35,6 +88,7 @@ func (ll *LinkedList[T]) AddFirst(value T) {
 		newNode.Next = ll.Head
 		ll.Head = newNode
 	}
+	ll.size++
 }
 
 func (ll *LinkedList[T]) AddLast(value T) {
@@ -46,6 +100,7 @@ func (ll *LinkedList[T]) AddLast(value T) {
 		ll.Tail.Next = newNode
 		ll.Tail = newNode
 	}
+	ll.size++
 }
 
 func (ll *LinkedList[T]) RemoveFirst() *Node[T] {
@@ -57,6 +112,7 @@ func (ll *LinkedList[T]) RemoveFirst() *Node[T] {
 	if ll.Head == nil {
 		ll.Tail = nil
 	}
+	ll.size--
 	return removedNode
 }
 
@@ -68,6 +124,7 @@ func (ll *LinkedList[T]) RemoveLast() *Node[T] {
 		removedNode := ll.Head
 		ll.Head = nil
 		ll.Tail = nil
+		ll.size--
 		return removedNode
 	}
 	current := ll.Head
@@ -77,45 +134,350 @@ func (ll *LinkedList[T]) RemoveLast() *Node[T] {
 	removedNode := ll.Tail
 	ll.Tail = current
 	ll.Tail.Next = nil
+	ll.size--
 	return removedNode
 }
 
-func (ll *LinkedList[T]) IndexAt(index int) *Node[T] {
-	current := ll.Head
-	for i := 0; current != nil && i < index; i++ {
-		current = current.Next
+// IndexAt returns the node at index.
+// It returns common.ErrIndexOutOfRange if index is negative or not less than Len.
+func (ll *LinkedList[T]) IndexAt(index int) (*Node[T], error) {
+	if err := ll.checkIndex(index, ll.size-1); err != nil {
+		return nil, err
 	}
-	return current
+	return ll.nodeAt(index), nil
 }
 
-func (ll *LinkedList[T]) RemoveAt(index int) *Node[T] {
-	if index == 0 {
-		return ll.RemoveFirst()
+// Set replaces the value of the node at index.
+// It returns common.ErrIndexOutOfRange if index is negative or not less than Len.
+func (ll *LinkedList[T]) Set(index int, value T) error {
+	node, err := ll.IndexAt(index)
+	if err != nil {
+		return err
 	}
-	prev := ll.IndexAt(index - 1)
-	if prev == nil || prev.Next == nil {
-		return nil
+	node.Value = value
+	return nil
+}
+
+// RemoveAt removes and returns the node at index.
+// It returns common.ErrIndexOutOfRange if index is negative or not less than Len.
+func (ll *LinkedList[T]) RemoveAt(index int) (*Node[T], error) {
+	if err := ll.checkIndex(index, ll.size-1); err != nil {
+		return nil, err
 	}
+	if index == 0 {
+		return ll.RemoveFirst(), nil
+	}
+	prev := ll.nodeAt(index - 1)
 	removedNode := prev.Next
 	prev.Next = removedNode.Next
 	if removedNode == ll.Tail {
 		ll.Tail = prev
 	}
-	return removedNode
+	ll.size--
+	return removedNode, nil
 }
 
-func (ll *LinkedList[T]) AddAt(index int, value T) {
+// AddAt inserts a value so that it ends up at index; an index equal to Len appends it.
+// It returns common.ErrIndexOutOfRange if index is negative or greater than Len.
+func (ll *LinkedList[T]) AddAt(index int, value T) error {
+	if err := ll.checkIndex(index, ll.size); err != nil {
+		return err
+	}
 	if index == 0 {
 		ll.AddFirst(value)
-		return
-	}
-	prev := ll.IndexAt(index - 1)
-	if prev == nil {
-		return
+		return nil
 	}
+	prev := ll.nodeAt(index - 1)
 	newNode := &Node[T]{Value: value, Next: prev.Next}
 	prev.Next = newNode
 	if newNode.Next == nil {
 		ll.Tail = newNode
 	}
+	ll.size++
+	return nil
+}
+
+// Find returns the first node holding value, or nil if the list does not contain it.
+func (ll *LinkedList[T]) Find(value T) *Node[T] {
+	for current := ll.Head; current != nil; current = current.Next {
+		if valuesEqual(ll.equals, current.Value, value) {
+			return current
+		}
+	}
+	return nil
+}
+
+// Contains checks if the list contains value.
+func (ll *LinkedList[T]) Contains(value T) bool {
+	return ll.Find(value) != nil
+}
+
+// IndexOf returns the index of the first node holding value, or -1 if the list does not contain it.
+func (ll *LinkedList[T]) IndexOf(value T) int {
+	i := 0
+	for current := ll.Head; current != nil; current = current.Next {
+		if valuesEqual(ll.equals, current.Value, value) {
+			return i
+		}
+		i++
+	}
+	return -1
+}
+
+// Remove removes the first node holding value.
+// It returns false if the list does not contain value.
+func (ll *LinkedList[T]) Remove(value T) bool {
+	var prev *Node[T]
+	for current := ll.Head; current != nil; prev, current = current, current.Next {
+		if !valuesEqual(ll.equals, current.Value, value) {
+			continue
+		}
+
+		if prev == nil {
+			ll.Head = current.Next
+		} else {
+			prev.Next = current.Next
+		}
+		if current == ll.Tail {
+			ll.Tail = prev
+		}
+		current.Next = nil
+		ll.size--
+		return true
+	}
+	return false
+}
+
+// ToSlice returns a slice containing the values of the list, from head to tail.
+func (ll *LinkedList[T]) ToSlice() []T {
+	result := make([]T, 0)
+	for current := ll.Head; current != nil; current = current.Next {
+		result = append(result, current.Value)
+	}
+	return result
+}
+
+// Reverse reverses the order of the nodes in place.
+func (ll *LinkedList[T]) Reverse() {
+	var prev *Node[T]
+	current := ll.Head
+	ll.Tail = ll.Head
+	for current != nil {
+		next := current.Next
+		current.Next = prev
+		prev = current
+		current = next
+	}
+	ll.Head = prev
+}
+
+// Concat moves every node of other to the end of the list in O(1), leaving other empty.
+// Concatenating a list with itself does nothing.
+func (ll *LinkedList[T]) Concat(other *LinkedList[T]) {
+	if other == nil || other == ll || other.Head == nil {
+		return
+	}
+
+	if ll.Tail == nil {
+		ll.Head = other.Head
+	} else {
+		ll.Tail.Next = other.Head
+	}
+	ll.Tail = other.Tail
+	ll.size += other.size
+	other.Head = nil
+	other.Tail = nil
+	other.size = 0
+}
+
+// SplitAt splits the list into two lists, the first holding the nodes before index and
+// the second holding the nodes from index onwards. The nodes are moved, not copied, so the
+// list is left empty. An index past either end yields an empty first or second list.
+func (ll *LinkedList[T]) SplitAt(index int) (*LinkedList[T], *LinkedList[T]) {
+	index = min(max(index, 0), ll.size)
+	front := &LinkedList[T]{equals: ll.equals, size: index}
+	back := &LinkedList[T]{equals: ll.equals, size: ll.size - index}
+
+	switch {
+	case index == 0:
+		back.Head, back.Tail = ll.Head, ll.Tail
+	case index == ll.size:
+		front.Head, front.Tail = ll.Head, ll.Tail
+	default:
+		front.Head, front.Tail = ll.Head, ll.nodeAt(index-1)
+		back.Head, back.Tail = front.Tail.Next, ll.Tail
+		front.Tail.Next = nil
+	}
+
+	ll.Head = nil
+	ll.Tail = nil
+	ll.size = 0
+	return front, back
+}
+
+// Sort sorts the list in place with a bottom-up merge sort, which is stable, runs in
+// O(n log n) and relinks the existing nodes without allocating.
+// less reports whether a must sort before b.
+func (ll *LinkedList[T]) Sort(less func(a, b T) bool) {
+	if ll.Head == nil || ll.Head.Next == nil {
+		return
+	}
+
+	for width := 1; ; width *= 2 {
+		var head, tail *Node[T]
+		merges := 0
+
+		for current := ll.Head; current != nil; {
+			left := current
+			right := cutAfter(left, width)
+			current = cutAfter(right, width)
+
+			mergedHead, mergedTail := mergeRuns(left, right, less)
+			if head == nil {
+				head = mergedHead
+			} else {
+				tail.Next = mergedHead
+			}
+			tail = mergedTail
+			merges++
+		}
+
+		ll.Head, ll.Tail = head, tail
+		if merges <= 1 {
+			return
+		}
+	}
+}
+
+// All returns an iterator over the index and value pairs of the list, from head to tail.
+// The list must not be modified while iterating.
+func (ll *LinkedList[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		i := 0
+		for current := ll.Head; current != nil; current = current.Next {
+			if !yield(i, current.Value) {
+				return
+			}
+			i++
+		}
+	}
+}
+
+// Backward returns an iterator over the index and value pairs of the list, from tail to head.
+// Since nodes only link forward, it records the nodes in a slice before yielding them.
+// The list must not be modified while iterating.
+func (ll *LinkedList[T]) Backward() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		var nodes []*Node[T]
+		for current := ll.Head; current != nil; current = current.Next {
+			nodes = append(nodes, current)
+		}
+		for i := len(nodes) - 1; i >= 0; i-- {
+			if !yield(i, nodes[i].Value) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over the values of the list, from head to tail.
+// The list must not be modified while iterating.
+func (ll *LinkedList[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for current := ll.Head; current != nil; current = current.Next {
+			if !yield(current.Value) {
+				return
+			}
+		}
+	}
+}
+
+// Drain returns an iterator that removes values from the head of the list while ranging over them.
+// Breaking out of the loop leaves the remaining values in the list.
+func (ll *LinkedList[T]) Drain() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for ll.Head != nil {
+			if !yield(ll.RemoveFirst().Value) {
+				return
+			}
+		}
+	}
+}
+
+// nodeAt walks to the node at index, which must be within bounds.
+func (ll *LinkedList[T]) nodeAt(index int) *Node[T] {
+	current := ll.Head
+	for i := 0; i < index; i++ {
+		current = current.Next
+	}
+	return current
+}
+
+// checkIndex returns common.ErrIndexOutOfRange if index is outside [0, last].
+func (ll *LinkedList[T]) checkIndex(index, last int) error {
+	if index < 0 || index > last {
+		return fmt.Errorf("%w: index %d with length %d", common.ErrIndexOutOfRange, index, ll.size)
+	}
+	return nil
+}
+
+// cutAfter detaches the run of at most n nodes starting at node and returns the node that followed it.
+func cutAfter[T any](node *Node[T], n int) *Node[T] {
+	for i := 1; node != nil && i < n; i++ {
+		node = node.Next
+	}
+	if node == nil {
+		return nil
+	}
+	rest := node.Next
+	node.Next = nil
+	return rest
+}
+
+// mergeRuns merges two sorted runs, taking from left on ties to keep the sort stable,
+// and returns the head and tail of the merged run. The left run must not be empty.
+func mergeRuns[T any](left, right *Node[T], less func(a, b T) bool) (*Node[T], *Node[T]) {
+	var head, tail *Node[T]
+	for left != nil && right != nil {
+		var next *Node[T]
+		if less(right.Value, left.Value) {
+			next, right = right, right.Next
+		} else {
+			next, left = left, left.Next
+		}
+
+		if head == nil {
+			head = next
+		} else {
+			tail.Next = next
+		}
+		tail = next
+	}
+
+	rest := left
+	if rest == nil {
+		rest = right
+	}
+	if head == nil {
+		head, tail = rest, rest
+	} else {
+		tail.Next = rest
+	}
+	for tail.Next != nil {
+		tail = tail.Next
+	}
+	return head, tail
+}
+
+// valuesEqual compares a and b with equals, or with reflect.DeepEqual when no equality function was supplied.
+func valuesEqual[T any](equals func(a, b T) bool, a, b T) bool {
+	if equals == nil {
+		return reflect.DeepEqual(a, b)
+	}
+	return equals(a, b)
+}
+
+// itemEquals compares two items with their Equals method.
+func itemEquals[T any, I common.Item[T]](a, b I) bool {
+	return a.Equals(b)
 }
@@ -0,0 +1,612 @@
+package linkedlist
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/sosalejandro/algo-practice/data-structures/common"
+)
+
+func newTestList(values ...int) *LinkedList[int] {
+	ll := &LinkedList[int]{}
+	for _, value := range values {
+		ll.AddLast(value)
+	}
+	return ll
+}
+
+func TestLinkedList_Iterators(t *testing.T) {
+	tests := []struct {
+		name         string
+		initial      []int
+		wantForward  []int
+		wantBackward []int
+	}{
+		{
+			name:         "Empty list",
+			initial:      []int{},
+			wantForward:  []int{},
+			wantBackward: []int{},
+		},
+		{
+			name:         "Single element",
+			initial:      []int{1},
+			wantForward:  []int{1},
+			wantBackward: []int{1},
+		},
+		{
+			name:         "Multiple elements",
+			initial:      []int{1, 2, 3},
+			wantForward:  []int{1, 2, 3},
+			wantBackward: []int{3, 2, 1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ll := newTestList(tt.initial...)
+
+			forward := make([]int, 0)
+			for i, value := range ll.All() {
+				if i != len(forward) {
+					t.Errorf("expected index %v, got %v", len(forward), i)
+				}
+				forward = append(forward, value)
+			}
+			if !reflect.DeepEqual(forward, tt.wantForward) {
+				t.Errorf("expected %v, got %v", tt.wantForward, forward)
+			}
+
+			values := make([]int, 0)
+			for value := range ll.Values() {
+				values = append(values, value)
+			}
+			if !reflect.DeepEqual(values, tt.wantForward) {
+				t.Errorf("expected %v, got %v", tt.wantForward, values)
+			}
+
+			backward := make([]int, 0)
+			for i, value := range ll.Backward() {
+				if i != len(tt.wantBackward)-1-len(backward) {
+					t.Errorf("expected index %v, got %v", len(tt.wantBackward)-1-len(backward), i)
+				}
+				backward = append(backward, value)
+			}
+			if !reflect.DeepEqual(backward, tt.wantBackward) {
+				t.Errorf("expected %v, got %v", tt.wantBackward, backward)
+			}
+		})
+	}
+}
+
+func TestLinkedList_Drain(t *testing.T) {
+	tests := []struct {
+		name          string
+		initial       []int
+		stopAfter     int
+		wantDrained   []int
+		wantRemaining []int
+	}{
+		{
+			name:          "Drain empty list",
+			initial:       []int{},
+			stopAfter:     -1,
+			wantDrained:   []int{},
+			wantRemaining: []int{},
+		},
+		{
+			name:          "Drain whole list",
+			initial:       []int{1, 2, 3},
+			stopAfter:     -1,
+			wantDrained:   []int{1, 2, 3},
+			wantRemaining: []int{},
+		},
+		{
+			name:          "Stop draining early",
+			initial:       []int{1, 2, 3},
+			stopAfter:     2,
+			wantDrained:   []int{1, 2},
+			wantRemaining: []int{3},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ll := newTestList(tt.initial...)
+
+			drained := make([]int, 0)
+			for value := range ll.Drain() {
+				drained = append(drained, value)
+				if len(drained) == tt.stopAfter {
+					break
+				}
+			}
+			if !reflect.DeepEqual(drained, tt.wantDrained) {
+				t.Errorf("expected %v, got %v", tt.wantDrained, drained)
+			}
+
+			remaining := make([]int, 0)
+			for value := range ll.Values() {
+				remaining = append(remaining, value)
+			}
+			if !reflect.DeepEqual(remaining, tt.wantRemaining) {
+				t.Errorf("expected %v, got %v", tt.wantRemaining, remaining)
+			}
+			if len(tt.wantRemaining) == 0 && (ll.Head != nil || ll.Tail != nil) {
+				t.Error("expected Head and Tail to be nil after draining")
+			}
+		})
+	}
+}
+
+type testPoint struct {
+	x, y int
+}
+
+type testStringItem struct {
+	value string
+}
+
+func (t *testStringItem) Equals(other common.Item[string]) bool {
+	return t.value == other.Value()
+}
+
+func (t *testStringItem) Value() string {
+	return t.value
+}
+
+func (t *testStringItem) IsEmpty() bool {
+	return t.value == ""
+}
+
+func TestLinkedList_ValueOperations(t *testing.T) {
+	tests := []struct {
+		name          string
+		initial       []int
+		value         int
+		wantIndex     int
+		wantRemaining []int
+	}{
+		{
+			name:          "Empty list",
+			initial:       []int{},
+			value:         1,
+			wantIndex:     -1,
+			wantRemaining: []int{},
+		},
+		{
+			name:          "Value at head",
+			initial:       []int{1, 2, 3},
+			value:         1,
+			wantIndex:     0,
+			wantRemaining: []int{2, 3},
+		},
+		{
+			name:          "Value at tail",
+			initial:       []int{1, 2, 3},
+			value:         3,
+			wantIndex:     2,
+			wantRemaining: []int{1, 2},
+		},
+		{
+			name:          "Only first occurrence is removed",
+			initial:       []int{1, 2, 1},
+			value:         1,
+			wantIndex:     0,
+			wantRemaining: []int{2, 1},
+		},
+		{
+			name:          "Missing value",
+			initial:       []int{1, 2, 3},
+			value:         4,
+			wantIndex:     -1,
+			wantRemaining: []int{1, 2, 3},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ll := NewLinkedList[int]()
+			for _, value := range tt.initial {
+				ll.AddLast(value)
+			}
+
+			if got := ll.IndexOf(tt.value); got != tt.wantIndex {
+				t.Errorf("expected index %v, got %v", tt.wantIndex, got)
+			}
+			if got := ll.Contains(tt.value); got != (tt.wantIndex >= 0) {
+				t.Errorf("expected contains %v, got %v", tt.wantIndex >= 0, got)
+			}
+			if node := ll.Find(tt.value); (node != nil) != (tt.wantIndex >= 0) || (node != nil && node.Value != tt.value) {
+				t.Errorf("expected to find %v, got %v", tt.value, node)
+			}
+			if got := ll.Remove(tt.value); got != (tt.wantIndex >= 0) {
+				t.Errorf("expected remove %v, got %v", tt.wantIndex >= 0, got)
+			}
+
+			remaining := make([]int, 0)
+			for value := range ll.Values() {
+				remaining = append(remaining, value)
+			}
+			if !reflect.DeepEqual(remaining, tt.wantRemaining) {
+				t.Errorf("expected %v, got %v", tt.wantRemaining, remaining)
+			}
+			if len(remaining) > 0 && ll.Tail.Value != remaining[len(remaining)-1] {
+				t.Errorf("expected tail %v, got %v", remaining[len(remaining)-1], ll.Tail.Value)
+			}
+			if len(remaining) == 0 && (ll.Head != nil || ll.Tail != nil) {
+				t.Error("expected Head and Tail to be nil")
+			}
+		})
+	}
+}
+
+func TestLinkedList_Equality(t *testing.T) {
+	tests := []struct {
+		name      string
+		indexOf   func() int
+		wantIndex int
+	}{
+		{
+			name: "Comparable structs",
+			indexOf: func() int {
+				ll := NewLinkedList[testPoint]()
+				ll.AddLast(testPoint{1, 2})
+				ll.AddLast(testPoint{3, 4})
+				return ll.IndexOf(testPoint{3, 4})
+			},
+			wantIndex: 1,
+		},
+		{
+			name: "Custom equality function",
+			indexOf: func() int {
+				ll := NewLinkedListFunc(func(a, b *testPoint) bool { return a.x == b.x })
+				ll.AddLast(&testPoint{1, 2})
+				ll.AddLast(&testPoint{3, 4})
+				return ll.IndexOf(&testPoint{3, 0})
+			},
+			wantIndex: 1,
+		},
+		{
+			name: "Items compared with Equals",
+			indexOf: func() int {
+				ll := NewItemLinkedList[string, *testStringItem]()
+				ll.AddLast(&testStringItem{value: "A"})
+				ll.AddLast(&testStringItem{value: "B"})
+				return ll.IndexOf(&testStringItem{value: "B"})
+			},
+			wantIndex: 1,
+		},
+		{
+			name: "Zero value list of slices",
+			indexOf: func() int {
+				ll := &LinkedList[[]int]{}
+				ll.AddLast([]int{1, 2})
+				ll.AddLast([]int{3, 4})
+				return ll.IndexOf([]int{3, 4})
+			},
+			wantIndex: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.indexOf(); got != tt.wantIndex {
+				t.Errorf("expected index %v, got %v", tt.wantIndex, got)
+			}
+		})
+	}
+}
+
+// checkLinkedList verifies that the list holds want, that Len matches and that Tail points at its last node.
+func checkLinkedList(t *testing.T, ll *LinkedList[int], want []int) {
+	t.Helper()
+
+	if got := ll.ToSlice(); !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+	if ll.Len() != len(want) {
+		t.Errorf("expected length %v, got %v", len(want), ll.Len())
+	}
+	if len(want) == 0 {
+		if ll.Head != nil || ll.Tail != nil {
+			t.Error("expected Head and Tail to be nil")
+		}
+		return
+	}
+	if ll.Tail == nil || ll.Tail.Next != nil || ll.Tail.Value != want[len(want)-1] {
+		t.Errorf("expected Tail to be the last node %v, got %v", want[len(want)-1], ll.Tail)
+	}
+}
+
+func TestLinkedList_FromSliceToSlice(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []int
+	}{
+		{name: "Empty slice", values: []int{}},
+		{name: "Single value", values: []int{1}},
+		{name: "Multiple values", values: []int{1, 2, 3}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checkLinkedList(t, FromSlice(tt.values), tt.values)
+		})
+	}
+}
+
+func TestLinkedList_Reverse(t *testing.T) {
+	tests := []struct {
+		name    string
+		initial []int
+		want    []int
+	}{
+		{name: "Empty list", initial: []int{}, want: []int{}},
+		{name: "Single element", initial: []int{1}, want: []int{1}},
+		{name: "Two elements", initial: []int{1, 2}, want: []int{2, 1}},
+		{name: "Multiple elements", initial: []int{1, 2, 3, 4}, want: []int{4, 3, 2, 1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ll := FromSlice(tt.initial)
+			ll.Reverse()
+			checkLinkedList(t, ll, tt.want)
+		})
+	}
+}
+
+func TestLinkedList_Concat(t *testing.T) {
+	tests := []struct {
+		name  string
+		left  []int
+		right []int
+		want  []int
+	}{
+		{name: "Both empty", left: []int{}, right: []int{}, want: []int{}},
+		{name: "Empty left", left: []int{}, right: []int{1, 2}, want: []int{1, 2}},
+		{name: "Empty right", left: []int{1, 2}, right: []int{}, want: []int{1, 2}},
+		{name: "Both non-empty", left: []int{1, 2}, right: []int{3, 4}, want: []int{1, 2, 3, 4}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			left, right := FromSlice(tt.left), FromSlice(tt.right)
+			left.Concat(right)
+
+			checkLinkedList(t, left, tt.want)
+			checkLinkedList(t, right, []int{})
+
+			left.AddLast(5)
+			checkLinkedList(t, left, append(tt.want, 5))
+		})
+	}
+
+	t.Run("Concat with itself", func(t *testing.T) {
+		ll := FromSlice([]int{1, 2})
+		ll.Concat(ll)
+		checkLinkedList(t, ll, []int{1, 2})
+	})
+}
+
+func TestLinkedList_SplitAt(t *testing.T) {
+	tests := []struct {
+		name      string
+		initial   []int
+		index     int
+		wantFront []int
+		wantBack  []int
+	}{
+		{name: "Empty list", initial: []int{}, index: 1, wantFront: []int{}, wantBack: []int{}},
+		{name: "Split at head", initial: []int{1, 2, 3}, index: 0, wantFront: []int{}, wantBack: []int{1, 2, 3}},
+		{name: "Negative index", initial: []int{1, 2, 3}, index: -1, wantFront: []int{}, wantBack: []int{1, 2, 3}},
+		{name: "Split in the middle", initial: []int{1, 2, 3, 4}, index: 2, wantFront: []int{1, 2}, wantBack: []int{3, 4}},
+		{name: "Split before tail", initial: []int{1, 2, 3}, index: 2, wantFront: []int{1, 2}, wantBack: []int{3}},
+		{name: "Split at length", initial: []int{1, 2, 3}, index: 3, wantFront: []int{1, 2, 3}, wantBack: []int{}},
+		{name: "Split past the end", initial: []int{1, 2, 3}, index: 5, wantFront: []int{1, 2, 3}, wantBack: []int{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ll := FromSlice(tt.initial)
+			front, back := ll.SplitAt(tt.index)
+
+			checkLinkedList(t, front, tt.wantFront)
+			checkLinkedList(t, back, tt.wantBack)
+			checkLinkedList(t, ll, []int{})
+		})
+	}
+}
+
+func TestLinkedList_Sort(t *testing.T) {
+	tests := []struct {
+		name    string
+		initial []int
+		want    []int
+	}{
+		{name: "Empty list", initial: []int{}, want: []int{}},
+		{name: "Single element", initial: []int{1}, want: []int{1}},
+		{name: "Already sorted", initial: []int{1, 2, 3, 4}, want: []int{1, 2, 3, 4}},
+		{name: "Reverse sorted", initial: []int{5, 4, 3, 2, 1}, want: []int{1, 2, 3, 4, 5}},
+		{name: "Duplicates", initial: []int{3, 1, 2, 3, 1}, want: []int{1, 1, 2, 3, 3}},
+		{name: "Odd length", initial: []int{7, 3, 9, 1, 5, 8, 2}, want: []int{1, 2, 3, 5, 7, 8, 9}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ll := FromSlice(tt.initial)
+			ll.Sort(func(a, b int) bool { return a < b })
+			checkLinkedList(t, ll, tt.want)
+		})
+	}
+
+	t.Run("Stable", func(t *testing.T) {
+		ll := FromSlice([]testPoint{{2, 0}, {1, 0}, {2, 1}, {1, 1}, {2, 2}, {1, 2}})
+		ll.Sort(func(a, b testPoint) bool { return a.x < b.x })
+
+		want := []testPoint{{1, 0}, {1, 1}, {1, 2}, {2, 0}, {2, 1}, {2, 2}}
+		if got := ll.ToSlice(); !reflect.DeepEqual(got, want) {
+			t.Errorf("expected %v, got %v", want, got)
+		}
+		if ll.Tail.Value != want[len(want)-1] {
+			t.Errorf("expected tail %v, got %v", want[len(want)-1], ll.Tail.Value)
+		}
+	})
+}
+
+func TestLinkedList_IndexOperations(t *testing.T) {
+	tests := []struct {
+		name      string
+		initial   []int
+		operation func(ll *LinkedList[int]) (int, error)
+		wantValue int
+		wantError error
+		want      []int
+	}{
+		{
+			name:    "IndexAt within bounds",
+			initial: []int{1, 2, 3},
+			operation: func(ll *LinkedList[int]) (int, error) {
+				node, err := ll.IndexAt(2)
+				if err != nil {
+					return 0, err
+				}
+				return node.Value, nil
+			},
+			wantValue: 3,
+			want:      []int{1, 2, 3},
+		},
+		{
+			name:    "IndexAt negative index",
+			initial: []int{1, 2, 3},
+			operation: func(ll *LinkedList[int]) (int, error) {
+				_, err := ll.IndexAt(-1)
+				return 0, err
+			},
+			wantError: common.ErrIndexOutOfRange,
+			want:      []int{1, 2, 3},
+		},
+		{
+			name:    "IndexAt past the end",
+			initial: []int{1, 2, 3},
+			operation: func(ll *LinkedList[int]) (int, error) {
+				_, err := ll.IndexAt(3)
+				return 0, err
+			},
+			wantError: common.ErrIndexOutOfRange,
+			want:      []int{1, 2, 3},
+		},
+		{
+			name:    "Set within bounds",
+			initial: []int{1, 2, 3},
+			operation: func(ll *LinkedList[int]) (int, error) {
+				return 0, ll.Set(1, 5)
+			},
+			want: []int{1, 5, 3},
+		},
+		{
+			name:    "Set on empty list",
+			initial: []int{},
+			operation: func(ll *LinkedList[int]) (int, error) {
+				return 0, ll.Set(0, 5)
+			},
+			wantError: common.ErrIndexOutOfRange,
+			want:      []int{},
+		},
+		{
+			name:    "RemoveAt head",
+			initial: []int{1, 2, 3},
+			operation: func(ll *LinkedList[int]) (int, error) {
+				node, err := ll.RemoveAt(0)
+				if err != nil {
+					return 0, err
+				}
+				return node.Value, nil
+			},
+			wantValue: 1,
+			want:      []int{2, 3},
+		},
+		{
+			name:    "RemoveAt tail",
+			initial: []int{1, 2, 3},
+			operation: func(ll *LinkedList[int]) (int, error) {
+				node, err := ll.RemoveAt(2)
+				if err != nil {
+					return 0, err
+				}
+				return node.Value, nil
+			},
+			wantValue: 3,
+			want:      []int{1, 2},
+		},
+		{
+			name:    "RemoveAt past the end",
+			initial: []int{1, 2, 3},
+			operation: func(ll *LinkedList[int]) (int, error) {
+				_, err := ll.RemoveAt(3)
+				return 0, err
+			},
+			wantError: common.ErrIndexOutOfRange,
+			want:      []int{1, 2, 3},
+		},
+		{
+			name:    "AddAt head",
+			initial: []int{2, 3},
+			operation: func(ll *LinkedList[int]) (int, error) {
+				return 0, ll.AddAt(0, 1)
+			},
+			want: []int{1, 2, 3},
+		},
+		{
+			name:    "AddAt middle",
+			initial: []int{1, 3},
+			operation: func(ll *LinkedList[int]) (int, error) {
+				return 0, ll.AddAt(1, 2)
+			},
+			want: []int{1, 2, 3},
+		},
+		{
+			name:    "AddAt length appends",
+			initial: []int{1, 2},
+			operation: func(ll *LinkedList[int]) (int, error) {
+				return 0, ll.AddAt(2, 3)
+			},
+			want: []int{1, 2, 3},
+		},
+		{
+			name:    "AddAt past the end",
+			initial: []int{1, 2},
+			operation: func(ll *LinkedList[int]) (int, error) {
+				return 0, ll.AddAt(3, 3)
+			},
+			wantError: common.ErrIndexOutOfRange,
+			want:      []int{1, 2},
+		},
+		{
+			name:    "AddAt negative index",
+			initial: []int{1, 2},
+			operation: func(ll *LinkedList[int]) (int, error) {
+				return 0, ll.AddAt(-1, 3)
+			},
+			wantError: common.ErrIndexOutOfRange,
+			want:      []int{1, 2},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ll := FromSlice(tt.initial)
+
+			value, err := tt.operation(ll)
+			if !errors.Is(err, tt.wantError) {
+				t.Fatalf("expected error %v, got %v", tt.wantError, err)
+			}
+			if value != tt.wantValue {
+				t.Errorf("expected value %v, got %v", tt.wantValue, value)
+			}
+			checkLinkedList(t, ll, tt.want)
+		})
+	}
+}
@@ -0,0 +1,3 @@
+module github.com/sosalejandro/algo-practice/data-structures/priority-queue
+
+go 1.23.2
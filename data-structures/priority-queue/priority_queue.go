@@ -0,0 +1,208 @@
+package priority_queue
+
+import (
+	"cmp"
+	"errors"
+
+	"github.com/sosalejandro/algo-practice/data-structures/common"
+)
+
+var (
+	// ErrInvalidHandle is returned when a handle is nil, was already removed, or belongs to another queue.
+	ErrInvalidHandle = errors.New("handle does not belong to the priority queue")
+	// ErrInvalidPriority is returned by DecreaseKey when the new priority would move the element backwards.
+	ErrInvalidPriority = errors.New("priority cannot be increased by DecreaseKey")
+)
+
+// Handle refers to an element stored in a PriorityQueue.
+// It is returned by Push and is used to change the element's priority or to remove it.
+type Handle[V any, P any] struct {
+	value    V
+	priority P
+	// index is the position of the handle in the heap, or -1 once it has left the queue.
+	index int
+	queue *PriorityQueue[V, P]
+}
+
+// Value returns the value of the element.
+func (h *Handle[V, P]) Value() V {
+	return h.value
+}
+
+// Priority returns the current priority of the element.
+func (h *Handle[V, P]) Priority() P {
+	return h.priority
+}
+
+// PriorityQueue is a generic d-ary heap of values ordered by a separate priority.
+// Pop returns the element whose priority comes first according to less,
+// so a less of a < b yields a min-heap.
+type PriorityQueue[V any, P any] struct {
+	items []*Handle[V, P]
+	less  func(a, b P) bool
+	arity int
+}
+
+// NewPriorityQueue creates a new binary min-heap ordered by the natural order of the priorities.
+func NewPriorityQueue[V any, P cmp.Ordered]() *PriorityQueue[V, P] {
+	return NewPriorityQueueFunc[V](cmp.Less[P])
+}
+
+// NewPriorityQueueFunc creates a new binary heap ordered by less.
+func NewPriorityQueueFunc[V any, P any](less func(a, b P) bool) *PriorityQueue[V, P] {
+	return NewDaryPriorityQueue[V](2, less)
+}
+
+// NewDaryPriorityQueue creates a new heap where every node has up to arity children.
+// Wider heaps are shallower, which makes Push and DecreaseKey cheaper at the cost of a slower Pop.
+// An arity less than two creates a binary heap.
+func NewDaryPriorityQueue[V any, P any](arity int, less func(a, b P) bool) *PriorityQueue[V, P] {
+	return &PriorityQueue[V, P]{
+		items: make([]*Handle[V, P], 0),
+		less:  less,
+		arity: max(arity, 2),
+	}
+}
+
+// Push adds a value with the given priority and returns a handle to it.
+func (pq *PriorityQueue[V, P]) Push(value V, priority P) *Handle[V, P] {
+	h := &Handle[V, P]{value: value, priority: priority, index: len(pq.items), queue: pq}
+	pq.items = append(pq.items, h)
+	pq.up(h.index)
+	return h
+}
+
+// Pop removes and returns the handle of the element with the highest priority.
+// It returns common.ErrEmpty if the queue is empty.
+func (pq *PriorityQueue[V, P]) Pop() (*Handle[V, P], error) {
+	if len(pq.items) == 0 {
+		return nil, common.ErrEmpty
+	}
+	return pq.removeAt(0), nil
+}
+
+// Peek returns the handle of the element with the highest priority without removing it,
+// or nil if the queue is empty.
+func (pq *PriorityQueue[V, P]) Peek() *Handle[V, P] {
+	if len(pq.items) == 0 {
+		return nil
+	}
+	return pq.items[0]
+}
+
+// Update changes the priority of the element referred to by h and restores the heap order.
+// It returns ErrInvalidHandle if h is not in the queue.
+func (pq *PriorityQueue[V, P]) Update(h *Handle[V, P], priority P) error {
+	if !pq.Contains(h) {
+		return ErrInvalidHandle
+	}
+	h.priority = priority
+	if !pq.up(h.index) {
+		pq.down(h.index)
+	}
+	return nil
+}
+
+// DecreaseKey moves the element referred to by h towards the front of the queue by giving it priority.
+// It returns ErrInvalidHandle if h is not in the queue, or ErrInvalidPriority if priority
+// comes after the element's current priority.
+func (pq *PriorityQueue[V, P]) DecreaseKey(h *Handle[V, P], priority P) error {
+	if !pq.Contains(h) {
+		return ErrInvalidHandle
+	}
+	if pq.less(h.priority, priority) {
+		return ErrInvalidPriority
+	}
+	h.priority = priority
+	pq.up(h.index)
+	return nil
+}
+
+// Remove removes the element referred to by h from the queue.
+// It returns ErrInvalidHandle if h is not in the queue.
+func (pq *PriorityQueue[V, P]) Remove(h *Handle[V, P]) error {
+	if !pq.Contains(h) {
+		return ErrInvalidHandle
+	}
+	pq.removeAt(h.index)
+	return nil
+}
+
+// Contains checks if h refers to an element that is still in the queue.
+func (pq *PriorityQueue[V, P]) Contains(h *Handle[V, P]) bool {
+	return h != nil && h.queue == pq && h.index >= 0
+}
+
+// IsEmpty checks if the queue is empty.
+func (pq *PriorityQueue[V, P]) IsEmpty() bool {
+	return len(pq.items) == 0
+}
+
+// Size returns the number of elements in the queue.
+func (pq *PriorityQueue[V, P]) Size() int {
+	return len(pq.items)
+}
+
+// Clear removes all elements from the queue, invalidating every outstanding handle.
+func (pq *PriorityQueue[V, P]) Clear() {
+	for _, h := range pq.items {
+		h.index = -1
+	}
+	pq.items = make([]*Handle[V, P], 0)
+}
+
+// removeAt removes the handle at index i, moving the last handle into its place.
+func (pq *PriorityQueue[V, P]) removeAt(i int) *Handle[V, P] {
+	h := pq.items[i]
+	last := len(pq.items) - 1
+	if i != last {
+		pq.swap(i, last)
+	}
+	pq.items[last] = nil
+	pq.items = pq.items[:last]
+
+	if i != last && !pq.up(i) {
+		pq.down(i)
+	}
+	h.index = -1
+	return h
+}
+
+// up moves the handle at index i towards the root until its parent comes first.
+// It reports whether the handle moved.
+func (pq *PriorityQueue[V, P]) up(i int) bool {
+	start := i
+	for i > 0 {
+		parent := (i - 1) / pq.arity
+		if !pq.less(pq.items[i].priority, pq.items[parent].priority) {
+			break
+		}
+		pq.swap(i, parent)
+		i = parent
+	}
+	return i != start
+}
+
+// down moves the handle at index i away from the root until it comes before all of its children.
+func (pq *PriorityQueue[V, P]) down(i int) {
+	for {
+		first := i
+		for child := pq.arity*i + 1; child <= pq.arity*i+pq.arity && child < len(pq.items); child++ {
+			if pq.less(pq.items[child].priority, pq.items[first].priority) {
+				first = child
+			}
+		}
+		if first == i {
+			return
+		}
+		pq.swap(i, first)
+		i = first
+	}
+}
+
+// swap exchanges the handles at indices i and j, keeping their indices in sync.
+func (pq *PriorityQueue[V, P]) swap(i, j int) {
+	pq.items[i], pq.items[j] = pq.items[j], pq.items[i]
+	pq.items[i].index = i
+	pq.items[j].index = j
+}
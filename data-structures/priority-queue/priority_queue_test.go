@@ -0,0 +1,288 @@
+package priority_queue
+
+import (
+	"cmp"
+	"errors"
+	"math/rand"
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/sosalejandro/algo-practice/data-structures/common"
+)
+
+// popAll pops every element and returns their values in pop order.
+func popAll(t *testing.T, pq *PriorityQueue[string, int]) []string {
+	t.Helper()
+
+	result := make([]string, 0)
+	for !pq.IsEmpty() {
+		h, err := pq.Pop()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		result = append(result, h.Value())
+	}
+	return result
+}
+
+func TestPriorityQueue_PopOrder(t *testing.T) {
+	tests := []struct {
+		name  string
+		arity int
+		less  func(a, b int) bool
+		want  func(priorities []int) []int
+	}{
+		{
+			name:  "Binary min-heap",
+			arity: 2,
+			less:  cmp.Less[int],
+			want: func(priorities []int) []int {
+				slices.Sort(priorities)
+				return priorities
+			},
+		},
+		{
+			name:  "Binary max-heap",
+			arity: 2,
+			less:  func(a, b int) bool { return a > b },
+			want: func(priorities []int) []int {
+				slices.Sort(priorities)
+				slices.Reverse(priorities)
+				return priorities
+			},
+		},
+		{
+			name:  "4-ary min-heap",
+			arity: 4,
+			less:  cmp.Less[int],
+			want: func(priorities []int) []int {
+				slices.Sort(priorities)
+				return priorities
+			},
+		},
+		{
+			name:  "Invalid arity falls back to binary",
+			arity: 0,
+			less:  cmp.Less[int],
+			want: func(priorities []int) []int {
+				slices.Sort(priorities)
+				return priorities
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			random := rand.New(rand.NewSource(1))
+			pq := NewDaryPriorityQueue[int](tt.arity, tt.less)
+
+			priorities := make([]int, 200)
+			for i := range priorities {
+				priorities[i] = random.Intn(50)
+				pq.Push(priorities[i], priorities[i])
+			}
+
+			got := make([]int, 0, len(priorities))
+			for !pq.IsEmpty() {
+				h, err := pq.Pop()
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if h.Value() != h.Priority() {
+					t.Fatalf("expected value %v to keep priority %v", h.Value(), h.Priority())
+				}
+				got = append(got, h.Priority())
+			}
+
+			if want := tt.want(priorities); !reflect.DeepEqual(got, want) {
+				t.Errorf("expected %v, got %v", want, got)
+			}
+		})
+	}
+}
+
+func TestPriorityQueue_Handles(t *testing.T) {
+	tests := []struct {
+		name      string
+		operation func(pq *PriorityQueue[string, int], handles map[string]*Handle[string, int]) error
+		wantError error
+		want      []string
+	}{
+		{
+			name: "DecreaseKey moves element to the front",
+			operation: func(pq *PriorityQueue[string, int], handles map[string]*Handle[string, int]) error {
+				return pq.DecreaseKey(handles["D"], 0)
+			},
+			want: []string{"D", "A", "B", "C"},
+		},
+		{
+			name: "DecreaseKey to the same priority",
+			operation: func(pq *PriorityQueue[string, int], handles map[string]*Handle[string, int]) error {
+				return pq.DecreaseKey(handles["B"], 2)
+			},
+			want: []string{"A", "B", "C", "D"},
+		},
+		{
+			name: "DecreaseKey rejects a larger priority",
+			operation: func(pq *PriorityQueue[string, int], handles map[string]*Handle[string, int]) error {
+				return pq.DecreaseKey(handles["A"], 10)
+			},
+			wantError: ErrInvalidPriority,
+			want:      []string{"A", "B", "C", "D"},
+		},
+		{
+			name: "Update can increase priority",
+			operation: func(pq *PriorityQueue[string, int], handles map[string]*Handle[string, int]) error {
+				return pq.Update(handles["A"], 10)
+			},
+			want: []string{"B", "C", "D", "A"},
+		},
+		{
+			name: "Update can decrease priority",
+			operation: func(pq *PriorityQueue[string, int], handles map[string]*Handle[string, int]) error {
+				return pq.Update(handles["C"], 0)
+			},
+			want: []string{"C", "A", "B", "D"},
+		},
+		{
+			name: "Remove root",
+			operation: func(pq *PriorityQueue[string, int], handles map[string]*Handle[string, int]) error {
+				return pq.Remove(handles["A"])
+			},
+			want: []string{"B", "C", "D"},
+		},
+		{
+			name: "Remove leaf",
+			operation: func(pq *PriorityQueue[string, int], handles map[string]*Handle[string, int]) error {
+				return pq.Remove(handles["D"])
+			},
+			want: []string{"A", "B", "C"},
+		},
+		{
+			name: "Remove twice",
+			operation: func(pq *PriorityQueue[string, int], handles map[string]*Handle[string, int]) error {
+				pq.Remove(handles["B"])
+				return pq.Remove(handles["B"])
+			},
+			wantError: ErrInvalidHandle,
+			want:      []string{"A", "C", "D"},
+		},
+		{
+			name: "Popped handle is invalid",
+			operation: func(pq *PriorityQueue[string, int], handles map[string]*Handle[string, int]) error {
+				pq.Pop()
+				return pq.Update(handles["A"], 0)
+			},
+			wantError: ErrInvalidHandle,
+			want:      []string{"B", "C", "D"},
+		},
+		{
+			name: "Handle from another queue",
+			operation: func(pq *PriorityQueue[string, int], handles map[string]*Handle[string, int]) error {
+				other := NewPriorityQueue[string, int]()
+				return pq.Remove(other.Push("E", 0))
+			},
+			wantError: ErrInvalidHandle,
+			want:      []string{"A", "B", "C", "D"},
+		},
+		{
+			name: "Nil handle",
+			operation: func(pq *PriorityQueue[string, int], handles map[string]*Handle[string, int]) error {
+				return pq.DecreaseKey(nil, 0)
+			},
+			wantError: ErrInvalidHandle,
+			want:      []string{"A", "B", "C", "D"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pq := NewPriorityQueue[string, int]()
+			handles := map[string]*Handle[string, int]{
+				"C": pq.Push("C", 3),
+				"A": pq.Push("A", 1),
+				"D": pq.Push("D", 4),
+				"B": pq.Push("B", 2),
+			}
+
+			err := tt.operation(pq, handles)
+			if !errors.Is(err, tt.wantError) {
+				t.Fatalf("expected error %v, got %v", tt.wantError, err)
+			}
+			if got := popAll(t, pq); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestPriorityQueue_Empty(t *testing.T) {
+	pq := NewPriorityQueue[string, int]()
+
+	if _, err := pq.Pop(); !errors.Is(err, common.ErrEmpty) {
+		t.Errorf("expected error %v, got %v", common.ErrEmpty, err)
+	}
+	if h := pq.Peek(); h != nil {
+		t.Errorf("expected nil, got %v", h.Value())
+	}
+
+	h := pq.Push("A", 1)
+	if pq.Peek() != h || pq.Size() != 1 {
+		t.Errorf("expected Peek to return the only handle")
+	}
+
+	pq.Clear()
+	if !pq.IsEmpty() || pq.Contains(h) {
+		t.Errorf("expected Clear to empty the queue and invalidate handles")
+	}
+}
+
+func TestPriorityQueue_RandomUpdates(t *testing.T) {
+	for _, arity := range []int{2, 3, 4} {
+		random := rand.New(rand.NewSource(int64(arity)))
+		pq := NewDaryPriorityQueue[int](arity, cmp.Less[int])
+		priorities := make(map[int]int)
+		handles := make([]*Handle[int, int], 0)
+
+		for i := 0; i < 300; i++ {
+			priorities[i] = random.Intn(1000)
+			handles = append(handles, pq.Push(i, priorities[i]))
+		}
+		for i := 0; i < 300; i++ {
+			h := handles[random.Intn(len(handles))]
+			if !pq.Contains(h) {
+				continue
+			}
+			switch random.Intn(3) {
+			case 0:
+				pq.Remove(h)
+				delete(priorities, h.Value())
+			case 1:
+				priorities[h.Value()] = random.Intn(1000)
+				pq.Update(h, priorities[h.Value()])
+			default:
+				priorities[h.Value()] = h.Priority() - random.Intn(100)
+				pq.DecreaseKey(h, priorities[h.Value()])
+			}
+		}
+
+		want := make([]int, 0, len(priorities))
+		for _, priority := range priorities {
+			want = append(want, priority)
+		}
+		slices.Sort(want)
+
+		got := make([]int, 0, len(want))
+		for !pq.IsEmpty() {
+			h, _ := pq.Pop()
+			if h.Priority() != priorities[h.Value()] {
+				t.Fatalf("arity %v: expected priority %v for %v, got %v", arity, priorities[h.Value()], h.Value(), h.Priority())
+			}
+			got = append(got, h.Priority())
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("arity %v: expected %v, got %v", arity, want, got)
+		}
+	}
+}
@@ -0,0 +1,174 @@
+package simple_queue
+
+import (
+	"context"
+	"sync"
+
+	"github.com/sosalejandro/algo-practice/data-structures/common"
+)
+
+// ConcurrentQueue is a Queue that is safe for use by multiple goroutines.
+// Besides the non-blocking operations of Queue, it offers DequeueCtx, which
+// waits until an element is available, the context is done, or the queue is closed.
+type ConcurrentQueue[T any, I common.Item[T]] struct {
+	mu     sync.Mutex
+	queue  *Queue[T, I]
+	closed bool
+	// notify is closed and replaced whenever waiters should re-check the queue.
+	notify chan struct{}
+}
+
+// NewConcurrentQueue creates a new instance of an unbounded ConcurrentQueue.
+func NewConcurrentQueue[T any, I common.Item[T]]() *ConcurrentQueue[T, I] {
+	return &ConcurrentQueue[T, I]{
+		queue:  NewQueue[T, I](),
+		notify: make(chan struct{}),
+	}
+}
+
+// NewBoundedConcurrentQueue creates a new instance of ConcurrentQueue that holds at most capacity elements.
+// A capacity less than or equal to zero creates an unbounded queue.
+func NewBoundedConcurrentQueue[T any, I common.Item[T]](capacity int) *ConcurrentQueue[T, I] {
+	return &ConcurrentQueue[T, I]{
+		queue:  NewBoundedQueue[T, I](capacity),
+		notify: make(chan struct{}),
+	}
+}
+
+// Enqueue adds an element to the end of the queue and wakes up any waiting DequeueCtx callers.
+// It returns common.ErrInvalidItem if the element is nil or empty, common.ErrFull
+// if a bounded queue is at capacity, or common.ErrClosed if the queue has been closed.
+func (q *ConcurrentQueue[T, I]) Enqueue(element I) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.closed {
+		return common.ErrClosed
+	}
+	if err := q.queue.Enqueue(element); err != nil {
+		return err
+	}
+
+	q.broadcast()
+	return nil
+}
+
+// Dequeue removes and returns the element at the front of the queue without blocking.
+// It returns common.ErrEmpty if the queue is empty.
+func (q *ConcurrentQueue[T, I]) Dequeue() (I, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return q.queue.Dequeue()
+}
+
+// TryDequeue removes and returns the element at the front of the queue without blocking.
+// The boolean result reports whether an element was dequeued.
+func (q *ConcurrentQueue[T, I]) TryDequeue() (I, bool) {
+	element, err := q.Dequeue()
+	return element, err == nil
+}
+
+// DequeueCtx removes and returns the element at the front of the queue,
+// blocking until an element is available.
+// It returns the context's error if ctx is done first, or common.ErrClosed
+// if the queue is closed and no elements remain.
+func (q *ConcurrentQueue[T, I]) DequeueCtx(ctx context.Context) (I, error) {
+	var zero I
+	for {
+		q.mu.Lock()
+		if !q.queue.IsEmpty() {
+			element, err := q.queue.Dequeue()
+			q.mu.Unlock()
+			return element, err
+		}
+		if q.closed {
+			q.mu.Unlock()
+			return zero, common.ErrClosed
+		}
+		notify := q.notify
+		q.mu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return zero, ctx.Err()
+		case <-notify:
+		}
+	}
+}
+
+// Peek returns the element at the front of the queue without removing it.
+func (q *ConcurrentQueue[T, I]) Peek() I {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return q.queue.Peek()
+}
+
+// IsEmpty checks if the queue is empty.
+func (q *ConcurrentQueue[T, I]) IsEmpty() bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return q.queue.IsEmpty()
+}
+
+// Size returns the number of elements in the queue.
+func (q *ConcurrentQueue[T, I]) Size() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return q.queue.Size()
+}
+
+// Clear removes all elements from the queue.
+func (q *ConcurrentQueue[T, I]) Clear() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.queue.Clear()
+}
+
+// ToSlice returns a snapshot of all elements in the queue, from front to back.
+func (q *ConcurrentQueue[T, I]) ToSlice() []I {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return q.queue.ToSlice()
+}
+
+// Contains checks if the queue contains the specified element.
+func (q *ConcurrentQueue[T, I]) Contains(element I) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return q.queue.Contains(element)
+}
+
+// Close closes the queue and wakes up all goroutines blocked in DequeueCtx.
+// Elements already in the queue can still be dequeued; further Enqueue calls fail.
+// Closing an already closed queue has no effect.
+func (q *ConcurrentQueue[T, I]) Close() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.closed {
+		return
+	}
+	q.closed = true
+	q.broadcast()
+}
+
+// IsClosed checks if the queue has been closed.
+func (q *ConcurrentQueue[T, I]) IsClosed() bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return q.closed
+}
+
+// broadcast wakes up every waiter. It must be called with q.mu held.
+func (q *ConcurrentQueue[T, I]) broadcast() {
+	close(q.notify)
+	q.notify = make(chan struct{})
+}
@@ -0,0 +1,189 @@
+package simple_queue
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sosalejandro/algo-practice/data-structures/common"
+)
+
+func TestConcurrentQueue_TryDequeue(t *testing.T) {
+	tests := []struct {
+		name     string
+		initial  []*testStringItem
+		expected *testStringItem
+		ok       bool
+	}{
+		{
+			name:     "TryDequeue from empty queue",
+			initial:  []*testStringItem{},
+			expected: nil,
+			ok:       false,
+		},
+		{
+			name: "TryDequeue from queue with elements",
+			initial: []*testStringItem{
+				{value: "element1"},
+				{value: "element2"},
+			},
+			expected: &testStringItem{value: "element1"},
+			ok:       true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			queue := NewConcurrentQueue[string, *testStringItem]()
+			for _, item := range tt.initial {
+				queue.Enqueue(item)
+			}
+
+			result, ok := queue.TryDequeue()
+			if ok != tt.ok {
+				t.Fatalf("expected ok: %v, got: %v", tt.ok, ok)
+			}
+			if tt.ok && !result.Equals(tt.expected) {
+				t.Errorf("expected: %v, got: %v", tt.expected.Value(), result.Value())
+			}
+		})
+	}
+}
+
+func TestConcurrentQueue_DequeueCtx(t *testing.T) {
+	tests := []struct {
+		name        string
+		initial     []*testStringItem
+		close       bool
+		expected    *testStringItem
+		expectError error
+	}{
+		{
+			name:        "Returns available element",
+			initial:     []*testStringItem{{value: "element1"}},
+			expected:    &testStringItem{value: "element1"},
+			expectError: nil,
+		},
+		{
+			name:        "Times out on empty queue",
+			initial:     []*testStringItem{},
+			expectError: context.DeadlineExceeded,
+		},
+		{
+			name:        "Fails on closed empty queue",
+			initial:     []*testStringItem{},
+			close:       true,
+			expectError: common.ErrClosed,
+		},
+		{
+			name:        "Drains closed queue",
+			initial:     []*testStringItem{{value: "element1"}},
+			close:       true,
+			expected:    &testStringItem{value: "element1"},
+			expectError: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			queue := NewConcurrentQueue[string, *testStringItem]()
+			for _, item := range tt.initial {
+				queue.Enqueue(item)
+			}
+			if tt.close {
+				queue.Close()
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+			defer cancel()
+
+			result, err := queue.DequeueCtx(ctx)
+			if !errors.Is(err, tt.expectError) {
+				t.Fatalf("expected error: %v, got: %v", tt.expectError, err)
+			}
+			if tt.expected != nil && !result.Equals(tt.expected) {
+				t.Errorf("expected: %v, got: %v", tt.expected.Value(), result.Value())
+			}
+		})
+	}
+}
+
+func TestConcurrentQueue_CloseWakesWaiters(t *testing.T) {
+	queue := NewConcurrentQueue[string, *testStringItem]()
+	waiters := 5
+
+	errs := make(chan error, waiters)
+	for i := 0; i < waiters; i++ {
+		go func() {
+			_, err := queue.DequeueCtx(context.Background())
+			errs <- err
+		}()
+	}
+
+	queue.Close()
+	for i := 0; i < waiters; i++ {
+		select {
+		case err := <-errs:
+			if !errors.Is(err, common.ErrClosed) {
+				t.Errorf("expected error: %v, got: %v", common.ErrClosed, err)
+			}
+		case <-time.After(time.Second):
+			t.Fatal("waiter was not woken up by Close")
+		}
+	}
+
+	if err := queue.Enqueue(&testStringItem{value: "element1"}); !errors.Is(err, common.ErrClosed) {
+		t.Errorf("expected error: %v, got: %v", common.ErrClosed, err)
+	}
+}
+
+func TestConcurrentQueue_ProducersConsumers(t *testing.T) {
+	queue := NewConcurrentQueue[string, *testStringItem]()
+	producers, consumers, perProducer := 4, 4, 250
+
+	var produced sync.WaitGroup
+	for p := 0; p < producers; p++ {
+		produced.Add(1)
+		go func(p int) {
+			defer produced.Done()
+			for i := 0; i < perProducer; i++ {
+				queue.Enqueue(&testStringItem{value: strconv.Itoa(p*perProducer + i + 1)})
+			}
+		}(p)
+	}
+
+	var (
+		mu       sync.Mutex
+		seen     = make(map[string]bool)
+		consumed sync.WaitGroup
+	)
+	for c := 0; c < consumers; c++ {
+		consumed.Add(1)
+		go func() {
+			defer consumed.Done()
+			for {
+				item, err := queue.DequeueCtx(context.Background())
+				if err != nil {
+					return
+				}
+				mu.Lock()
+				seen[item.Value()] = true
+				mu.Unlock()
+			}
+		}()
+	}
+
+	produced.Wait()
+	queue.Close()
+	consumed.Wait()
+
+	if len(seen) != producers*perProducer {
+		t.Errorf("expected %v distinct elements, got: %v", producers*perProducer, len(seen))
+	}
+	if !queue.IsEmpty() {
+		t.Errorf("expected queue to be empty, but it wasn't")
+	}
+}
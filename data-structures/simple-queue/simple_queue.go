@@ -1,11 +1,14 @@
 package simple_queue
 
 import (
-	"errors"
+	"iter"
 
 	"github.com/sosalejandro/algo-practice/data-structures/common"
 )
 
+// minCapacity is the smallest backing array an unbounded queue keeps around.
+const minCapacity = 16
+
 // QueueItem represents an item that can be stored in the queue.
 // It requires methods to check equality and retrieve the item’s underlying value.
 // type QueueItem[T any] interface {
@@ -15,69 +18,123 @@ import (
 // }
 
 // Queue represents a generic queue that holds elements of any type implementing QueueItem.
+// Elements are stored in a ring buffer, so Dequeue never reslices the backing array:
+// the buffer grows when it is full and shrinks again when occupancy drops to a quarter.
 type Queue[T any, I common.Item[T]] struct {
 	elements []I
+	head     int
+	size     int
+	capacity int
 }
 
-// NewQueue creates a new instance of Queue.
+// NewQueue creates a new instance of an unbounded Queue.
 func NewQueue[T any, I common.Item[T]]() *Queue[T, I] {
 	return &Queue[T, I]{
-		elements: make([]I, 0),
+		elements: make([]I, minCapacity),
+	}
+}
+
+// NewBoundedQueue creates a new instance of Queue that holds at most capacity elements.
+// Enqueue returns common.ErrFull once the queue is at capacity.
+// A capacity less than or equal to zero creates an unbounded queue.
+func NewBoundedQueue[T any, I common.Item[T]](capacity int) *Queue[T, I] {
+	if capacity <= 0 {
+		return NewQueue[T, I]()
+	}
+
+	return &Queue[T, I]{
+		elements: make([]I, min(capacity, minCapacity)),
+		capacity: capacity,
 	}
 }
 
 // Enqueue adds an element to the end of the queue.
-// It returns an error if the element is nil or empty.
+// It returns common.ErrInvalidItem if the element is nil or empty,
+// or common.ErrFull if a bounded queue is at capacity.
 func (q *Queue[T, I]) Enqueue(element I) error {
 	if element.IsEmpty() {
-		return errors.New("element cannot be nil or empty")
+		return common.ErrInvalidItem
+	}
+	if q.IsFull() {
+		return common.ErrFull
 	}
 
-	q.elements = append(q.elements, element)
+	if q.size == len(q.elements) {
+		q.resize(q.grownCapacity())
+	}
+
+	q.elements[(q.head+q.size)%len(q.elements)] = element
+	q.size++
 	return nil
 }
 
 // Dequeue removes and returns the element at the front of the queue.
-// It returns an error if the queue is empty.
+// It returns common.ErrEmpty if the queue is empty.
 func (q *Queue[T, I]) Dequeue() (I, error) {
 	var zero I
-	if len(q.elements) == 0 {
-		return zero, errors.New("queue is empty")
+	if q.size == 0 {
+		return zero, common.ErrEmpty
 	}
 
-	element := q.elements[0]
-	q.elements = q.elements[1:]
+	element := q.elements[q.head]
+	// Release the reference so the element can be garbage collected.
+	q.elements[q.head] = zero
+	q.head = (q.head + 1) % len(q.elements)
+	q.size--
+
+	if len(q.elements) > minCapacity && q.size <= len(q.elements)/4 {
+		q.resize(max(len(q.elements)/2, minCapacity))
+	}
 	return element, nil
 }
 
 // Peek returns the element at the front of the queue without removing it.
 func (q *Queue[T, I]) Peek() I {
 	var zero I
-	if len(q.elements) == 0 {
+	if q.size == 0 {
 		return zero
 	}
 
-	return q.elements[0]
+	return q.elements[q.head]
 }
 
 // IsEmpty checks if the queue is empty.
 func (q *Queue[T, I]) IsEmpty() bool {
-	return len(q.elements) == 0
+	return q.size == 0
+}
+
+// IsFull checks if a bounded queue has reached its capacity.
+// An unbounded queue is never full.
+func (q *Queue[T, I]) IsFull() bool {
+	return q.capacity > 0 && q.size >= q.capacity
 }
 
 // Size returns the number of elements in the queue.
 func (q *Queue[T, I]) Size() int {
-	return len(q.elements)
+	return q.size
+}
+
+// Capacity returns the maximum number of elements the queue can hold,
+// or zero if the queue is unbounded.
+func (q *Queue[T, I]) Capacity() int {
+	return q.capacity
 }
 
 // Clear removes all elements from the queue.
 func (q *Queue[T, I]) Clear() {
-	q.elements = make([]I, 0)
+	q.elements = make([]I, min(q.initialCapacity(), minCapacity))
+	q.head = 0
+	q.size = 0
 }
 
-// ToSlice returns a slice containing all elements in the queue.
+// ToSlice returns a slice containing all elements in the queue, from front to back.
+// The returned slice is a copy and does not share memory with the queue.
 func (q *Queue[T, I]) ToSlice() []I {
-	return q.elements
+	result := make([]I, q.size)
+	for i := range result {
+		result[i] = q.elements[(q.head+i)%len(q.elements)]
+	}
+	return result
 }
 
 // Contains checks if the queue contains the specified element.
@@ -87,10 +144,89 @@ func (q *Queue[T, I]) Contains(element I) bool {
 		return false
 	}
 
-	for _, curr := range q.elements {
-		if curr.Equals(element) {
+	for i := 0; i < q.size; i++ {
+		if q.elements[(q.head+i)%len(q.elements)].Equals(element) {
 			return true
 		}
 	}
 	return false
 }
+
+// All returns an iterator over the index and element pairs of the queue, from front to back.
+// The queue must not be modified while iterating.
+func (q *Queue[T, I]) All() iter.Seq2[int, I] {
+	return func(yield func(int, I) bool) {
+		for i := 0; i < q.size; i++ {
+			if !yield(i, q.elements[(q.head+i)%len(q.elements)]) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over the index and element pairs of the queue, from back to front.
+// The queue must not be modified while iterating.
+func (q *Queue[T, I]) Backward() iter.Seq2[int, I] {
+	return func(yield func(int, I) bool) {
+		for i := q.size - 1; i >= 0; i-- {
+			if !yield(i, q.elements[(q.head+i)%len(q.elements)]) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over the elements of the queue, from front to back.
+// The queue must not be modified while iterating.
+func (q *Queue[T, I]) Values() iter.Seq[I] {
+	return func(yield func(I) bool) {
+		for _, element := range q.All() {
+			if !yield(element) {
+				return
+			}
+		}
+	}
+}
+
+// Drain returns an iterator that dequeues elements while ranging over them.
+// Iteration stops once the queue is empty; elements enqueued during iteration are drained as well.
+// Breaking out of the loop leaves the remaining elements in the queue.
+func (q *Queue[T, I]) Drain() iter.Seq[I] {
+	return func(yield func(I) bool) {
+		for !q.IsEmpty() {
+			element, err := q.Dequeue()
+			if err != nil || !yield(element) {
+				return
+			}
+		}
+	}
+}
+
+// grownCapacity returns the size of the backing array to use when the buffer is full,
+// never exceeding the bound of a bounded queue.
+func (q *Queue[T, I]) grownCapacity() int {
+	grown := max(len(q.elements)*2, minCapacity)
+	if q.capacity > 0 {
+		grown = min(grown, q.capacity)
+	}
+	return grown
+}
+
+// initialCapacity returns the size of the backing array a fresh queue starts with.
+func (q *Queue[T, I]) initialCapacity() int {
+	if q.capacity > 0 {
+		return q.capacity
+	}
+	return minCapacity
+}
+
+// resize moves the elements into a new backing array of the given length,
+// placing the front of the queue at index zero.
+func (q *Queue[T, I]) resize(length int) {
+	elements := make([]I, length)
+	for i := 0; i < q.size; i++ {
+		elements[i] = q.elements[(q.head+i)%len(q.elements)]
+	}
+	q.elements = elements
+	q.head = 0
+}
@@ -1,6 +1,9 @@
 package simple_queue
 
 import (
+	"errors"
+	"reflect"
+	"strconv"
 	"testing"
 
 	"github.com/sosalejandro/algo-practice/data-structures/common"
@@ -368,3 +371,336 @@ func TestQueue_Contains(t *testing.T) {
 		})
 	}
 }
+
+func TestQueue_BoundedEnqueue(t *testing.T) {
+	tests := []struct {
+		name        string
+		capacity    int
+		initial     []*testStringItem
+		toEnqueue   *testStringItem
+		expectError error
+	}{
+		{
+			name:        "Enqueue below capacity",
+			capacity:    2,
+			initial:     []*testStringItem{{value: "element1"}},
+			toEnqueue:   &testStringItem{value: "element2"},
+			expectError: nil,
+		},
+		{
+			name:     "Enqueue at capacity",
+			capacity: 2,
+			initial: []*testStringItem{
+				{value: "element1"},
+				{value: "element2"},
+			},
+			toEnqueue:   &testStringItem{value: "element3"},
+			expectError: common.ErrFull,
+		},
+		{
+			name:     "Non-positive capacity is unbounded",
+			capacity: 0,
+			initial: []*testStringItem{
+				{value: "element1"},
+				{value: "element2"},
+			},
+			toEnqueue:   &testStringItem{value: "element3"},
+			expectError: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			queue := NewBoundedQueue[string, *testStringItem](tt.capacity)
+			for _, item := range tt.initial {
+				if err := queue.Enqueue(item); err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+			}
+
+			err := queue.Enqueue(tt.toEnqueue)
+			if !errors.Is(err, tt.expectError) {
+				t.Errorf("expected error: %v, got: %v", tt.expectError, err)
+			}
+		})
+	}
+}
+
+func TestQueue_WrapAround(t *testing.T) {
+	tests := []struct {
+		name     string
+		capacity int
+		rounds   int
+	}{
+		{
+			name:     "Unbounded queue",
+			capacity: 0,
+			rounds:   5 * minCapacity,
+		},
+		{
+			name:     "Bounded queue",
+			capacity: 3,
+			rounds:   10,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			queue := NewBoundedQueue[string, *testStringItem](tt.capacity)
+			next, expected := 0, 0
+
+			// Keep the queue partially filled so head and tail keep wrapping around the buffer.
+			for round := 0; round < tt.rounds; round++ {
+				for !queue.IsFull() && queue.Size() < 3 {
+					next++
+					queue.Enqueue(&testStringItem{value: strconv.Itoa(next)})
+				}
+
+				result, err := queue.Dequeue()
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				expected++
+				if result.Value() != strconv.Itoa(expected) {
+					t.Fatalf("expected: %v, got: %v", expected, result.Value())
+				}
+			}
+		})
+	}
+}
+
+func TestQueue_Shrink(t *testing.T) {
+	tests := []struct {
+		name        string
+		enqueue     int
+		dequeue     int
+		maxCapacity int
+	}{
+		{
+			name:        "Grows past minimum capacity",
+			enqueue:     10 * minCapacity,
+			dequeue:     0,
+			maxCapacity: 16 * minCapacity,
+		},
+		{
+			name:        "Shrinks when drained",
+			enqueue:     10 * minCapacity,
+			dequeue:     10 * minCapacity,
+			maxCapacity: minCapacity,
+		},
+		{
+			name:        "Shrinks when occupancy drops",
+			enqueue:     10 * minCapacity,
+			dequeue:     9 * minCapacity,
+			maxCapacity: 4 * minCapacity,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			queue := NewQueue[string, *testStringItem]()
+			for i := 1; i <= tt.enqueue; i++ {
+				queue.Enqueue(&testStringItem{value: strconv.Itoa(i)})
+			}
+			for i := 1; i <= tt.dequeue; i++ {
+				result, err := queue.Dequeue()
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if result.Value() != strconv.Itoa(i) {
+					t.Fatalf("expected: %v, got: %v", i, result.Value())
+				}
+			}
+
+			if queue.Size() != tt.enqueue-tt.dequeue {
+				t.Errorf("expected size: %v, got: %v", tt.enqueue-tt.dequeue, queue.Size())
+			}
+			if len(queue.elements) > tt.maxCapacity {
+				t.Errorf("expected backing array of at most %v, got: %v", tt.maxCapacity, len(queue.elements))
+			}
+		})
+	}
+}
+
+func TestQueue_SentinelErrors(t *testing.T) {
+	tests := []struct {
+		name        string
+		capacity    int
+		initial     []*testStringItem
+		operation   func(queue *Queue[string, *testStringItem]) error
+		expectError error
+	}{
+		{
+			name:    "Dequeue from empty queue",
+			initial: []*testStringItem{},
+			operation: func(queue *Queue[string, *testStringItem]) error {
+				_, err := queue.Dequeue()
+				return err
+			},
+			expectError: common.ErrEmpty,
+		},
+		{
+			name:    "Enqueue empty element",
+			initial: []*testStringItem{},
+			operation: func(queue *Queue[string, *testStringItem]) error {
+				return queue.Enqueue(&testStringItem{value: ""})
+			},
+			expectError: common.ErrInvalidItem,
+		},
+		{
+			name:     "Enqueue into full queue",
+			capacity: 1,
+			initial:  []*testStringItem{{value: "element1"}},
+			operation: func(queue *Queue[string, *testStringItem]) error {
+				return queue.Enqueue(&testStringItem{value: "element2"})
+			},
+			expectError: common.ErrFull,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			queue := NewBoundedQueue[string, *testStringItem](tt.capacity)
+			for _, item := range tt.initial {
+				queue.Enqueue(item)
+			}
+
+			err := tt.operation(queue)
+			if !errors.Is(err, tt.expectError) {
+				t.Errorf("expected error: %v, got: %v", tt.expectError, err)
+			}
+		})
+	}
+}
+
+func TestQueue_Iterators(t *testing.T) {
+	tests := []struct {
+		name     string
+		initial  []string
+		dequeue  int
+		forward  []string
+		backward []string
+	}{
+		{
+			name:     "Empty queue",
+			initial:  []string{},
+			forward:  []string{},
+			backward: []string{},
+		},
+		{
+			name:     "Queue with elements",
+			initial:  []string{"element1", "element2", "element3"},
+			forward:  []string{"element1", "element2", "element3"},
+			backward: []string{"element3", "element2", "element1"},
+		},
+		{
+			name:     "Queue wrapped around the buffer",
+			initial:  []string{"a", "b", "c", "d", "e", "f", "g", "h", "i", "j", "k", "l", "m", "n", "o", "p", "q"},
+			dequeue:  14,
+			forward:  []string{"o", "p", "q"},
+			backward: []string{"q", "p", "o"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			queue := NewQueue[string, *testStringItem]()
+			for _, value := range tt.initial {
+				queue.Enqueue(&testStringItem{value: value})
+			}
+			for i := 0; i < tt.dequeue; i++ {
+				queue.Dequeue()
+			}
+
+			forward := make([]string, 0)
+			for i, item := range queue.All() {
+				if i != len(forward) {
+					t.Errorf("expected index: %v, got: %v", len(forward), i)
+				}
+				forward = append(forward, item.Value())
+			}
+			if !reflect.DeepEqual(forward, tt.forward) {
+				t.Errorf("expected: %v, got: %v", tt.forward, forward)
+			}
+
+			values := make([]string, 0)
+			for item := range queue.Values() {
+				values = append(values, item.Value())
+			}
+			if !reflect.DeepEqual(values, tt.forward) {
+				t.Errorf("expected: %v, got: %v", tt.forward, values)
+			}
+
+			backward := make([]string, 0)
+			for i, item := range queue.Backward() {
+				if i != len(tt.backward)-1-len(backward) {
+					t.Errorf("expected index: %v, got: %v", len(tt.backward)-1-len(backward), i)
+				}
+				backward = append(backward, item.Value())
+			}
+			if !reflect.DeepEqual(backward, tt.backward) {
+				t.Errorf("expected: %v, got: %v", tt.backward, backward)
+			}
+
+			if queue.Size() != len(tt.forward) {
+				t.Errorf("expected iteration to leave %v elements, got: %v", len(tt.forward), queue.Size())
+			}
+		})
+	}
+}
+
+func TestQueue_Drain(t *testing.T) {
+	tests := []struct {
+		name      string
+		initial   []string
+		stopAfter int
+		drained   []string
+		remaining int
+	}{
+		{
+			name:      "Drain empty queue",
+			initial:   []string{},
+			stopAfter: -1,
+			drained:   []string{},
+			remaining: 0,
+		},
+		{
+			name:      "Drain whole queue",
+			initial:   []string{"element1", "element2", "element3"},
+			stopAfter: -1,
+			drained:   []string{"element1", "element2", "element3"},
+			remaining: 0,
+		},
+		{
+			name:      "Stop draining early",
+			initial:   []string{"element1", "element2", "element3"},
+			stopAfter: 1,
+			drained:   []string{"element1"},
+			remaining: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			queue := NewQueue[string, *testStringItem]()
+			for _, value := range tt.initial {
+				queue.Enqueue(&testStringItem{value: value})
+			}
+
+			drained := make([]string, 0)
+			for item := range queue.Drain() {
+				drained = append(drained, item.Value())
+				if len(drained) == tt.stopAfter {
+					break
+				}
+			}
+
+			if !reflect.DeepEqual(drained, tt.drained) {
+				t.Errorf("expected: %v, got: %v", tt.drained, drained)
+			}
+			if queue.Size() != tt.remaining {
+				t.Errorf("expected size: %v, got: %v", tt.remaining, queue.Size())
+			}
+		})
+	}
+}
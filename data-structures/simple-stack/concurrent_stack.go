@@ -0,0 +1,165 @@
+package simple_stack
+
+import (
+	"context"
+	"sync"
+
+	"github.com/sosalejandro/algo-practice/data-structures/common"
+)
+
+// ConcurrentStack is a Stack that is safe for use by multiple goroutines.
+// Besides the non-blocking operations of Stack, it offers PopCtx, which
+// waits until an element is available, the context is done, or the stack is closed.
+type ConcurrentStack[T any, I common.Item[T]] struct {
+	mu     sync.Mutex
+	stack  *Stack[T, I]
+	closed bool
+	// notify is closed and replaced whenever waiters should re-check the stack.
+	notify chan struct{}
+}
+
+// NewConcurrentStack creates a new instance of ConcurrentStack.
+func NewConcurrentStack[T any, I common.Item[T]]() *ConcurrentStack[T, I] {
+	return &ConcurrentStack[T, I]{
+		stack:  NewStack[T, I](),
+		notify: make(chan struct{}),
+	}
+}
+
+// Push adds an element to the top of the stack and wakes up any waiting PopCtx callers.
+// It returns common.ErrInvalidItem if the element is nil or empty,
+// or common.ErrClosed if the stack has been closed.
+func (s *ConcurrentStack[T, I]) Push(element I) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return common.ErrClosed
+	}
+	if err := s.stack.Push(element); err != nil {
+		return err
+	}
+
+	s.broadcast()
+	return nil
+}
+
+// Pop removes and returns the element at the top of the stack without blocking.
+// It returns common.ErrEmpty if the stack is empty.
+func (s *ConcurrentStack[T, I]) Pop() (I, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.stack.Pop()
+}
+
+// TryPop removes and returns the element at the top of the stack without blocking.
+// The boolean result reports whether an element was popped.
+func (s *ConcurrentStack[T, I]) TryPop() (I, bool) {
+	element, err := s.Pop()
+	return element, err == nil
+}
+
+// PopCtx removes and returns the element at the top of the stack,
+// blocking until an element is available.
+// It returns the context's error if ctx is done first, or common.ErrClosed
+// if the stack is closed and no elements remain.
+func (s *ConcurrentStack[T, I]) PopCtx(ctx context.Context) (I, error) {
+	var zero I
+	for {
+		s.mu.Lock()
+		if !s.stack.IsEmpty() {
+			element, err := s.stack.Pop()
+			s.mu.Unlock()
+			return element, err
+		}
+		if s.closed {
+			s.mu.Unlock()
+			return zero, common.ErrClosed
+		}
+		notify := s.notify
+		s.mu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return zero, ctx.Err()
+		case <-notify:
+		}
+	}
+}
+
+// Peek returns the element at the top of the stack without removing it.
+func (s *ConcurrentStack[T, I]) Peek() I {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.stack.Peek()
+}
+
+// IsEmpty checks if the stack is empty.
+func (s *ConcurrentStack[T, I]) IsEmpty() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.stack.IsEmpty()
+}
+
+// Size returns the number of elements in the stack.
+func (s *ConcurrentStack[T, I]) Size() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.stack.Size()
+}
+
+// Clear removes all elements from the stack.
+func (s *ConcurrentStack[T, I]) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.stack.Clear()
+}
+
+// ToSlice returns a snapshot of all elements in the stack, from bottom to top.
+func (s *ConcurrentStack[T, I]) ToSlice() []I {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.stack.ToSlice()
+}
+
+// Contains checks if the stack contains the specified element.
+func (s *ConcurrentStack[T, I]) Contains(element I) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.stack.Contains(element)
+}
+
+// Close closes the stack and wakes up all goroutines blocked in PopCtx.
+// Elements already on the stack can still be popped; further Push calls fail.
+// Closing an already closed stack has no effect.
+func (s *ConcurrentStack[T, I]) Close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return
+	}
+	s.closed = true
+	s.broadcast()
+}
+
+// IsClosed checks if the stack has been closed.
+func (s *ConcurrentStack[T, I]) IsClosed() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.closed
+}
+
+// broadcast wakes up every waiter. It must be called with s.mu held.
+func (s *ConcurrentStack[T, I]) broadcast() {
+	close(s.notify)
+	s.notify = make(chan struct{})
+}
@@ -0,0 +1,187 @@
+package simple_stack
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sosalejandro/algo-practice/data-structures/common"
+)
+
+func TestConcurrentStack_TryPop(t *testing.T) {
+	tests := []struct {
+		name    string
+		initial []IntItem
+		want    IntItem
+		wantOk  bool
+	}{
+		{
+			name:    "TryPop from empty stack",
+			initial: []IntItem{},
+			want:    IntItem{},
+			wantOk:  false,
+		},
+		{
+			name:    "TryPop from non-empty stack",
+			initial: []IntItem{{value: 1}, {value: 2}},
+			want:    IntItem{value: 2},
+			wantOk:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stack := NewConcurrentStack[int, IntItem]()
+			for _, item := range tt.initial {
+				stack.Push(item)
+			}
+
+			got, ok := stack.TryPop()
+			if ok != tt.wantOk {
+				t.Fatalf("expected ok %v, got %v", tt.wantOk, ok)
+			}
+			if !got.Equals(tt.want) {
+				t.Errorf("expected %v, got %v", tt.want.Value(), got.Value())
+			}
+		})
+	}
+}
+
+func TestConcurrentStack_PopCtx(t *testing.T) {
+	tests := []struct {
+		name      string
+		initial   []IntItem
+		close     bool
+		want      IntItem
+		wantError error
+	}{
+		{
+			name:      "Returns top element",
+			initial:   []IntItem{{value: 1}, {value: 2}},
+			want:      IntItem{value: 2},
+			wantError: nil,
+		},
+		{
+			name:      "Times out on empty stack",
+			initial:   []IntItem{},
+			want:      IntItem{},
+			wantError: context.DeadlineExceeded,
+		},
+		{
+			name:      "Fails on closed empty stack",
+			initial:   []IntItem{},
+			close:     true,
+			want:      IntItem{},
+			wantError: common.ErrClosed,
+		},
+		{
+			name:      "Drains closed stack",
+			initial:   []IntItem{{value: 1}},
+			close:     true,
+			want:      IntItem{value: 1},
+			wantError: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stack := NewConcurrentStack[int, IntItem]()
+			for _, item := range tt.initial {
+				stack.Push(item)
+			}
+			if tt.close {
+				stack.Close()
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+			defer cancel()
+
+			got, err := stack.PopCtx(ctx)
+			if !errors.Is(err, tt.wantError) {
+				t.Fatalf("expected error %v, got %v", tt.wantError, err)
+			}
+			if !got.Equals(tt.want) {
+				t.Errorf("expected %v, got %v", tt.want.Value(), got.Value())
+			}
+		})
+	}
+}
+
+func TestConcurrentStack_CloseWakesWaiters(t *testing.T) {
+	stack := NewConcurrentStack[int, IntItem]()
+	waiters := 5
+
+	errs := make(chan error, waiters)
+	for i := 0; i < waiters; i++ {
+		go func() {
+			_, err := stack.PopCtx(context.Background())
+			errs <- err
+		}()
+	}
+
+	stack.Close()
+	for i := 0; i < waiters; i++ {
+		select {
+		case err := <-errs:
+			if !errors.Is(err, common.ErrClosed) {
+				t.Errorf("expected error %v, got %v", common.ErrClosed, err)
+			}
+		case <-time.After(time.Second):
+			t.Fatal("waiter was not woken up by Close")
+		}
+	}
+
+	if err := stack.Push(IntItem{value: 1}); !errors.Is(err, common.ErrClosed) {
+		t.Errorf("expected error %v, got %v", common.ErrClosed, err)
+	}
+}
+
+func TestConcurrentStack_ProducersConsumers(t *testing.T) {
+	stack := NewConcurrentStack[int, IntItem]()
+	producers, consumers, perProducer := 4, 4, 250
+
+	var produced sync.WaitGroup
+	for p := 0; p < producers; p++ {
+		produced.Add(1)
+		go func(p int) {
+			defer produced.Done()
+			for i := 0; i < perProducer; i++ {
+				stack.Push(IntItem{value: p*perProducer + i + 1})
+			}
+		}(p)
+	}
+
+	var (
+		mu       sync.Mutex
+		seen     = make(map[int]bool)
+		consumed sync.WaitGroup
+	)
+	for c := 0; c < consumers; c++ {
+		consumed.Add(1)
+		go func() {
+			defer consumed.Done()
+			for {
+				item, err := stack.PopCtx(context.Background())
+				if err != nil {
+					return
+				}
+				mu.Lock()
+				seen[item.Value()] = true
+				mu.Unlock()
+			}
+		}()
+	}
+
+	produced.Wait()
+	stack.Close()
+	consumed.Wait()
+
+	if len(seen) != producers*perProducer {
+		t.Errorf("expected %v distinct elements, got %v", producers*perProducer, len(seen))
+	}
+	if !stack.IsEmpty() {
+		t.Error("expected stack to be empty")
+	}
+}
@@ -1,7 +1,8 @@
 package simple_stack
 
 import (
-	"errors"
+	"iter"
+	"slices"
 
 	"github.com/sosalejandro/algo-practice/data-structures/common"
 )
@@ -27,10 +28,10 @@ func NewStack[T any, I common.Item[T]]() *Stack[T, I] {
 }
 
 // Push adds an element to the top of the stack.
-// It returns an error if the element is nil or empty.
+// It returns common.ErrInvalidItem if the element is nil or empty.
 func (s *Stack[T, I]) Push(element I) error {
 	if element.IsEmpty() {
-		return errors.New("element cannot be nil or empty")
+		return common.ErrInvalidItem
 	}
 
 	s.elements = append(s.elements, element)
@@ -38,11 +39,11 @@ func (s *Stack[T, I]) Push(element I) error {
 }
 
 // Pop removes and returns the element at the top of the stack.
-// It returns an error if the stack is empty.
+// It returns common.ErrEmpty if the stack is empty.
 func (s *Stack[T, I]) Pop() (I, error) {
 	var zero I
 	if len(s.elements) == 0 {
-		return zero, errors.New("stack is empty")
+		return zero, common.ErrEmpty
 	}
 
 	element := s.elements[len(s.elements)-1]
@@ -75,9 +76,10 @@ func (s *Stack[T, I]) Clear() {
 	s.elements = make([]I, 0)
 }
 
-// ToSlice returns a slice containing all elements in the stack.
+// ToSlice returns a slice containing all elements in the stack, from bottom to top.
+// The returned slice is a copy and does not share memory with the stack.
 func (s *Stack[T, I]) ToSlice() []I {
-	return s.elements
+	return slices.Clone(s.elements)
 }
 
 // Contains checks if the stack contains the specified element.
@@ -94,3 +96,37 @@ func (s *Stack[T, I]) Contains(element I) bool {
 	}
 	return false
 }
+
+// All returns an iterator over the index and element pairs of the stack, from bottom to top,
+// matching the order of ToSlice.
+// The stack must not be modified while iterating.
+func (s *Stack[T, I]) All() iter.Seq2[int, I] {
+	return slices.All(s.elements)
+}
+
+// Backward returns an iterator over the index and element pairs of the stack, from top to bottom,
+// which is the order elements would be popped in.
+// The stack must not be modified while iterating.
+func (s *Stack[T, I]) Backward() iter.Seq2[int, I] {
+	return slices.Backward(s.elements)
+}
+
+// Values returns an iterator over the elements of the stack, from bottom to top.
+// The stack must not be modified while iterating.
+func (s *Stack[T, I]) Values() iter.Seq[I] {
+	return slices.Values(s.elements)
+}
+
+// Drain returns an iterator that pops elements while ranging over them.
+// Iteration stops once the stack is empty; elements pushed during iteration are drained as well.
+// Breaking out of the loop leaves the remaining elements on the stack.
+func (s *Stack[T, I]) Drain() iter.Seq[I] {
+	return func(yield func(I) bool) {
+		for !s.IsEmpty() {
+			element, err := s.Pop()
+			if err != nil || !yield(element) {
+				return
+			}
+		}
+	}
+}
@@ -1,6 +1,8 @@
 package simple_stack
 
 import (
+	"errors"
+	"reflect"
 	"testing"
 
 	"github.com/sosalejandro/algo-practice/data-structures/common"
@@ -299,3 +301,170 @@ func TestStack_Contains(t *testing.T) {
 		})
 	}
 }
+
+func TestStack_SentinelErrors(t *testing.T) {
+	tests := []struct {
+		name      string
+		initial   []IntItem
+		operation func(stack *Stack[int, IntItem]) error
+		wantError error
+	}{
+		{
+			name:    "Pop from empty stack",
+			initial: []IntItem{},
+			operation: func(stack *Stack[int, IntItem]) error {
+				_, err := stack.Pop()
+				return err
+			},
+			wantError: common.ErrEmpty,
+		},
+		{
+			name:    "Push empty element",
+			initial: []IntItem{{value: 1}},
+			operation: func(stack *Stack[int, IntItem]) error {
+				return stack.Push(IntItem{value: 0})
+			},
+			wantError: common.ErrInvalidItem,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stack := NewStack[int, IntItem]()
+			for _, item := range tt.initial {
+				stack.Push(item)
+			}
+
+			err := tt.operation(stack)
+			if !errors.Is(err, tt.wantError) {
+				t.Errorf("expected error %v, got %v", tt.wantError, err)
+			}
+		})
+	}
+}
+
+func TestStack_Iterators(t *testing.T) {
+	tests := []struct {
+		name         string
+		initial      []IntItem
+		wantForward  []int
+		wantBackward []int
+	}{
+		{
+			name:         "Empty stack",
+			initial:      []IntItem{},
+			wantForward:  []int{},
+			wantBackward: []int{},
+		},
+		{
+			name:         "Non-empty stack",
+			initial:      []IntItem{{value: 1}, {value: 2}, {value: 3}},
+			wantForward:  []int{1, 2, 3},
+			wantBackward: []int{3, 2, 1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stack := NewStack[int, IntItem]()
+			for _, item := range tt.initial {
+				stack.Push(item)
+			}
+
+			forward := make([]int, 0)
+			for i, item := range stack.All() {
+				if i != len(forward) {
+					t.Errorf("expected index %v, got %v", len(forward), i)
+				}
+				forward = append(forward, item.Value())
+			}
+			if !reflect.DeepEqual(forward, tt.wantForward) {
+				t.Errorf("expected %v, got %v", tt.wantForward, forward)
+			}
+
+			values := make([]int, 0)
+			for item := range stack.Values() {
+				values = append(values, item.Value())
+			}
+			if !reflect.DeepEqual(values, tt.wantForward) {
+				t.Errorf("expected %v, got %v", tt.wantForward, values)
+			}
+
+			backward := make([]int, 0)
+			for _, item := range stack.Backward() {
+				backward = append(backward, item.Value())
+			}
+			if !reflect.DeepEqual(backward, tt.wantBackward) {
+				t.Errorf("expected %v, got %v", tt.wantBackward, backward)
+			}
+		})
+	}
+}
+
+func TestStack_Drain(t *testing.T) {
+	tests := []struct {
+		name          string
+		initial       []IntItem
+		stopAfter     int
+		wantDrained   []int
+		wantRemaining int
+	}{
+		{
+			name:          "Drain empty stack",
+			initial:       []IntItem{},
+			stopAfter:     -1,
+			wantDrained:   []int{},
+			wantRemaining: 0,
+		},
+		{
+			name:          "Drain whole stack",
+			initial:       []IntItem{{value: 1}, {value: 2}, {value: 3}},
+			stopAfter:     -1,
+			wantDrained:   []int{3, 2, 1},
+			wantRemaining: 0,
+		},
+		{
+			name:          "Stop draining early",
+			initial:       []IntItem{{value: 1}, {value: 2}, {value: 3}},
+			stopAfter:     1,
+			wantDrained:   []int{3},
+			wantRemaining: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stack := NewStack[int, IntItem]()
+			for _, item := range tt.initial {
+				stack.Push(item)
+			}
+
+			drained := make([]int, 0)
+			for item := range stack.Drain() {
+				drained = append(drained, item.Value())
+				if len(drained) == tt.stopAfter {
+					break
+				}
+			}
+
+			if !reflect.DeepEqual(drained, tt.wantDrained) {
+				t.Errorf("expected %v, got %v", tt.wantDrained, drained)
+			}
+			if stack.Size() != tt.wantRemaining {
+				t.Errorf("expected size %v, got %v", tt.wantRemaining, stack.Size())
+			}
+		})
+	}
+}
+
+func TestStack_ToSliceIsCopy(t *testing.T) {
+	stack := NewStack[int, IntItem]()
+	stack.Push(IntItem{value: 1})
+
+	got := stack.ToSlice()
+	got[0] = IntItem{value: 2}
+
+	if top := stack.Peek(); top.Value() != 1 {
+		t.Errorf("expected %v, got %v", 1, top.Value())
+	}
+}
@@ -0,0 +1,3 @@
+module github.com/sosalejandro/algo-practice/data-structures/union-find
+
+go 1.23.2
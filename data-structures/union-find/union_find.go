@@ -0,0 +1,141 @@
+package union_find
+
+// UnionFind is a generic disjoint set union.
+// It keeps track of which elements belong to the same set as sets are merged,
+// which makes it a cheap way to maintain connectivity while the edges of a graph arrive one by one.
+// Find compresses paths and Union merges by rank, so every operation runs in near constant amortized time.
+type UnionFind[T comparable] struct {
+	parent map[T]T
+	rank   map[T]int
+	// size holds the number of elements of every set, keyed by its root.
+	size  map[T]int
+	count int
+}
+
+// NewUnionFind creates a new, empty UnionFind.
+func NewUnionFind[T comparable]() *UnionFind[T] {
+	return &UnionFind[T]{
+		parent: make(map[T]T),
+		rank:   make(map[T]int),
+		size:   make(map[T]int),
+	}
+}
+
+// NewUnionFindFromEdges creates a UnionFind where the two nodes of every edge are in the same set.
+// Edges use the format of graph.GenerateGraphFromEdges: edge[0] and edge[1] are the nodes,
+// and edges that don't have exactly two elements are omitted.
+func NewUnionFindFromEdges[T comparable](edges [][]T) *UnionFind[T] {
+	uf := NewUnionFind[T]()
+	uf.AddEdges(edges)
+	return uf
+}
+
+// Add adds element as a set of its own.
+// It returns false if the element was already present.
+func (uf *UnionFind[T]) Add(element T) bool {
+	if _, exists := uf.parent[element]; exists {
+		return false
+	}
+	uf.parent[element] = element
+	uf.rank[element] = 0
+	uf.size[element] = 1
+	uf.count++
+	return true
+}
+
+// Contains checks if element has been added.
+func (uf *UnionFind[T]) Contains(element T) bool {
+	_, exists := uf.parent[element]
+	return exists
+}
+
+// Find returns the representative of the set containing element.
+// Two elements are in the same set exactly when they have the same representative.
+// The boolean result reports whether element has been added.
+func (uf *UnionFind[T]) Find(element T) (T, bool) {
+	root, exists := uf.parent[element]
+	if !exists {
+		return element, false
+	}
+	for root != uf.parent[root] {
+		root = uf.parent[root]
+	}
+
+	// Point every element on the way directly at the root
+	for element != root {
+		next := uf.parent[element]
+		uf.parent[element] = root
+		element = next
+	}
+	return root, true
+}
+
+// Union merges the sets containing a and b, adding either element if it is not present yet.
+// It returns false if a and b were already in the same set.
+func (uf *UnionFind[T]) Union(a, b T) bool {
+	uf.Add(a)
+	uf.Add(b)
+	rootA, _ := uf.Find(a)
+	rootB, _ := uf.Find(b)
+	if rootA == rootB {
+		return false
+	}
+
+	// Hang the shallower tree under the deeper one to keep trees flat
+	if uf.rank[rootA] < uf.rank[rootB] {
+		rootA, rootB = rootB, rootA
+	}
+	uf.parent[rootB] = rootA
+	if uf.rank[rootA] == uf.rank[rootB] {
+		uf.rank[rootA]++
+	}
+	uf.size[rootA] += uf.size[rootB]
+	delete(uf.size, rootB)
+	delete(uf.rank, rootB)
+
+	uf.count--
+	return true
+}
+
+// Connected checks if a and b are in the same set.
+// It returns false if either element has not been added.
+func (uf *UnionFind[T]) Connected(a, b T) bool {
+	rootA, existsA := uf.Find(a)
+	rootB, existsB := uf.Find(b)
+	return existsA && existsB && rootA == rootB
+}
+
+// Count returns the number of disjoint sets.
+func (uf *UnionFind[T]) Count() int {
+	return uf.count
+}
+
+// ComponentSize returns the number of elements in the set containing element,
+// or zero if element has not been added.
+func (uf *UnionFind[T]) ComponentSize(element T) int {
+	root, exists := uf.Find(element)
+	if !exists {
+		return 0
+	}
+	return uf.size[root]
+}
+
+// Size returns the number of elements across all sets.
+func (uf *UnionFind[T]) Size() int {
+	return len(uf.parent)
+}
+
+// AddEdges merges the nodes of every edge, in order, and returns the number of sets after each edge,
+// so counts[i] is the number of connected components of the graph made of edges[:i+1].
+// Edges use the format of graph.GenerateGraphFromEdges; an edge that doesn't have exactly two elements
+// is omitted and leaves the count unchanged.
+func (uf *UnionFind[T]) AddEdges(edges [][]T) []int {
+	counts := make([]int, len(edges))
+	for i, edge := range edges {
+		if len(edge) == 2 {
+			uf.Union(edge[0], edge[1])
+		}
+		counts[i] = uf.count
+	}
+	return counts
+}
@@ -0,0 +1,130 @@
+package union_find
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestUnionFind(t *testing.T) {
+	uf := NewUnionFind[string]()
+
+	if !uf.Add("A") {
+		t.Errorf("expected Add to add a new element")
+	}
+	if uf.Add("A") {
+		t.Errorf("expected Add to ignore an existing element")
+	}
+
+	if !uf.Union("A", "B") {
+		t.Errorf("expected Union to merge two sets")
+	}
+	if !uf.Union("C", "D") {
+		t.Errorf("expected Union to merge two sets")
+	}
+	if uf.Union("B", "A") {
+		t.Errorf("expected Union of connected elements to report false")
+	}
+
+	if got := uf.Count(); got != 2 {
+		t.Errorf("expected 2 sets, got %v", got)
+	}
+	if got := uf.Size(); got != 4 {
+		t.Errorf("expected 4 elements, got %v", got)
+	}
+	if !uf.Connected("A", "B") || uf.Connected("A", "C") {
+		t.Errorf("expected A and B to be connected and A and C not to be")
+	}
+
+	uf.Union("B", "D")
+	if !uf.Connected("A", "C") {
+		t.Errorf("expected A and C to be connected after merging their sets")
+	}
+	if got := uf.ComponentSize("C"); got != 4 {
+		t.Errorf("expected component size 4, got %v", got)
+	}
+	if got := uf.Count(); got != 1 {
+		t.Errorf("expected 1 set, got %v", got)
+	}
+
+	rootA, _ := uf.Find("A")
+	for _, element := range []string{"B", "C", "D"} {
+		if root, _ := uf.Find(element); root != rootA {
+			t.Errorf("expected %v to share representative %v, got %v", element, rootA, root)
+		}
+	}
+}
+
+func TestUnionFind_UnknownElements(t *testing.T) {
+	uf := NewUnionFind[int]()
+	uf.Union(1, 2)
+
+	if _, found := uf.Find(3); found {
+		t.Errorf("expected Find to report an unknown element")
+	}
+	if uf.Connected(3, 3) {
+		t.Errorf("expected unknown elements not to be connected")
+	}
+	if got := uf.ComponentSize(3); got != 0 {
+		t.Errorf("expected component size 0, got %v", got)
+	}
+	if uf.Contains(3) || uf.Size() != 2 {
+		t.Errorf("expected lookups not to add elements")
+	}
+}
+
+func TestUnionFind_AddEdges(t *testing.T) {
+	tests := []struct {
+		name     string
+		edges    [][]string
+		expected []int
+		count    int
+	}{
+		{
+			name:     "Empty Edges",
+			edges:    [][]string{},
+			expected: []int{},
+			count:    0,
+		},
+		{
+			name:     "Incremental Connectivity",
+			edges:    [][]string{{"A", "B"}, {"C", "D"}, {"B", "C"}, {"A", "D"}, {"E", "F"}},
+			expected: []int{1, 2, 1, 1, 2},
+			count:    2,
+		},
+		{
+			name:     "Malformed Edges Are Omitted",
+			edges:    [][]string{{"A", "B"}, {"C"}, {"A", "B", "C"}, {"C", "C"}},
+			expected: []int{1, 1, 1, 2},
+			count:    2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uf := NewUnionFind[string]()
+			if got := uf.AddEdges(tt.edges); !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("expected counts %v, got %v", tt.expected, got)
+			}
+			if got := NewUnionFindFromEdges(tt.edges).Count(); got != tt.count {
+				t.Errorf("expected %v sets, got %v", tt.count, got)
+			}
+		})
+	}
+}
+
+func TestUnionFind_LongChain(t *testing.T) {
+	uf := NewUnionFind[int]()
+	for i := 1; i < 10000; i++ {
+		uf.Union(i, i+1)
+	}
+
+	if got := uf.Count(); got != 1 {
+		t.Errorf("expected 1 set, got %v", got)
+	}
+	if got := uf.ComponentSize(1); got != 10000 {
+		t.Errorf("expected component size 10000, got %v", got)
+	}
+	if !uf.Connected(1, 10000) {
+		t.Errorf("expected both ends of the chain to be connected")
+	}
+}
@@ -0,0 +1,91 @@
+package a_star
+
+import (
+	"slices"
+
+	priority_queue "github.com/sosalejandro/algo-practice/data-structures/priority-queue"
+	"github.com/sosalejandro/algo-practice/graph"
+)
+
+// NeighborsFunc returns the nodes reachable from node through a single edge, with the weight of that edge.
+// Graphs don't need to be materialized: the method value of a graph.WeightedGraph's Neighbors works,
+// and so does a function computing the neighbors of a grid cell on the fly, like GridNeighbors.
+// Weights must not be negative.
+type NeighborsFunc[T comparable, W graph.Weight] func(node T) []graph.Neighbor[T, W]
+
+// Heuristic estimates the cost of the cheapest path from node to goal.
+// Search only returns the cheapest path if the heuristic is admissible, that is,
+// if it never overestimates the actual cost.
+type Heuristic[T comparable, W graph.Weight] func(node, goal T) W
+
+// Search finds the cheapest path from src to dst using the A* algorithm,
+// which expands nodes in order of their known cost from src plus their estimated cost to dst.
+// It returns the path, both ends included, and its cost.
+// The boolean result reports whether dst is reachable from src.
+// A heuristic that always returns zero turns Search into Dijkstra's algorithm.
+func Search[T comparable, W graph.Weight](neighbors NeighborsFunc[T, W], src, dst T, heuristic Heuristic[T, W]) ([]T, W, bool) {
+	costs := map[T]W{src: 0}
+	predecessors := make(map[T]T)
+
+	// Open nodes are ordered by cost plus estimate. Handles of closed nodes are no longer in the queue;
+	// such a node is pushed again if an inconsistent heuristic made it close too early.
+	open := priority_queue.NewPriorityQueue[T, W]()
+	handles := map[T]*priority_queue.Handle[T, W]{src: open.Push(src, heuristic(src, dst))}
+
+	for !open.IsEmpty() {
+		current, err := open.Pop()
+		if err != nil {
+			break
+		}
+
+		node := current.Value()
+		if node == dst {
+			return buildPath(predecessors, src, dst), costs[dst], true
+		}
+
+		for _, neighbor := range neighbors(node) {
+			cost := costs[node] + neighbor.Weight
+			if known, discovered := costs[neighbor.Node]; discovered && cost >= known {
+				continue
+			}
+
+			costs[neighbor.Node] = cost
+			predecessors[neighbor.Node] = node
+
+			estimate := cost + heuristic(neighbor.Node, dst)
+			// Update fails with ErrInvalidHandle once the node has closed, in which case it is pushed again
+			if handle, exists := handles[neighbor.Node]; exists {
+				if err := open.Update(handle, estimate); err == nil {
+					continue
+				}
+			}
+			handles[neighbor.Node] = open.Push(neighbor.Node, estimate)
+		}
+	}
+
+	var zero W
+	return nil, zero, false
+}
+
+// AdjacencyNeighbors adapts an unweighted adjacency list, such as the one built by graph.GenerateGraphFromEdges,
+// into a NeighborsFunc where every edge has a weight of one.
+func AdjacencyNeighbors[T comparable, W graph.Weight](g map[T][]T) NeighborsFunc[T, W] {
+	return func(node T) []graph.Neighbor[T, W] {
+		result := make([]graph.Neighbor[T, W], 0, len(g[node]))
+		for _, neighbor := range g[node] {
+			result = append(result, graph.Neighbor[T, W]{Node: neighbor, Weight: 1})
+		}
+		return result
+	}
+}
+
+// buildPath walks the predecessors back from dst to src and returns the path in order.
+func buildPath[T comparable](predecessors map[T]T, src, dst T) []T {
+	path := []T{dst}
+	for node := dst; node != src; {
+		node = predecessors[node]
+		path = append(path, node)
+	}
+	slices.Reverse(path)
+	return path
+}
@@ -0,0 +1,97 @@
+package a_star
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/sosalejandro/algo-practice/graph"
+)
+
+func zeroHeuristic[T comparable](node, goal T) int {
+	return 0
+}
+
+func TestSearch_WeightedGraph(t *testing.T) {
+	g := graph.GenerateWeightedGraphFromEdges([]graph.WeightedEdge[string, int]{
+		{Src: "A", Dst: "B", Weight: 4},
+		{Src: "A", Dst: "C", Weight: 1},
+		{Src: "C", Dst: "B", Weight: 2},
+		{Src: "B", Dst: "D", Weight: 1},
+		{Src: "C", Dst: "D", Weight: 5},
+		{Src: "E", Dst: "A", Weight: 1},
+	}, graph.Directional)
+
+	// Remaining cost to D, never overestimated
+	estimates := map[string]int{"A": 3, "B": 1, "C": 2, "D": 0, "E": 4}
+	heuristic := func(node, goal string) int { return estimates[node] }
+
+	tests := []struct {
+		name      string
+		src       string
+		dst       string
+		heuristic Heuristic[string, int]
+		path      []string
+		cost      int
+		found     bool
+	}{
+		{name: "Admissible Heuristic", src: "A", dst: "D", heuristic: heuristic, path: []string{"A", "C", "B", "D"}, cost: 4, found: true},
+		{name: "Zero Heuristic", src: "A", dst: "D", heuristic: zeroHeuristic[string], path: []string{"A", "C", "B", "D"}, cost: 4, found: true},
+		{name: "Source Is Destination", src: "B", dst: "B", heuristic: zeroHeuristic[string], path: []string{"B"}, cost: 0, found: true},
+		{name: "Unreachable Destination", src: "A", dst: "E", heuristic: zeroHeuristic[string], found: false},
+		{name: "Unknown Source", src: "Z", dst: "A", heuristic: zeroHeuristic[string], found: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path, cost, found := Search(g.Neighbors, tt.src, tt.dst, tt.heuristic)
+			if found != tt.found {
+				t.Fatalf("expected found %v, got %v", tt.found, found)
+			}
+			if !reflect.DeepEqual(path, tt.path) {
+				t.Errorf("expected path %v, got %v", tt.path, path)
+			}
+			if cost != tt.cost {
+				t.Errorf("expected cost %v, got %v", tt.cost, cost)
+			}
+		})
+	}
+}
+
+func TestSearch_InconsistentHeuristic(t *testing.T) {
+	// The heuristic is admissible but not consistent: it makes C look worse than it is,
+	// so C is first closed through the expensive edge and must be reopened.
+	g := graph.GenerateWeightedGraphFromEdges([]graph.WeightedEdge[string, int]{
+		{Src: "S", Dst: "A", Weight: 1},
+		{Src: "S", Dst: "C", Weight: 4},
+		{Src: "A", Dst: "B", Weight: 1},
+		{Src: "B", Dst: "C", Weight: 1},
+		{Src: "C", Dst: "G", Weight: 3},
+	}, graph.Directional)
+	estimates := map[string]int{"S": 0, "A": 5, "B": 4, "C": 0, "G": 0}
+
+	path, cost, found := Search(g.Neighbors, "S", "G", func(node, goal string) int { return estimates[node] })
+	if !found {
+		t.Fatalf("expected a path")
+	}
+	if expected := []string{"S", "A", "B", "C", "G"}; !reflect.DeepEqual(path, expected) {
+		t.Errorf("expected path %v, got %v", expected, path)
+	}
+	if cost != 6 {
+		t.Errorf("expected cost 6, got %v", cost)
+	}
+}
+
+func TestAdjacencyNeighbors(t *testing.T) {
+	g := graph.GenerateGraphFromEdges([][]string{{"A", "B"}, {"B", "C"}, {"A", "D"}, {"D", "E"}, {"E", "C"}}, graph.Bidirectional)
+
+	path, cost, found := Search(AdjacencyNeighbors[string, int](g), "A", "C", zeroHeuristic[string])
+	if !found {
+		t.Fatalf("expected a path")
+	}
+	if expected := []string{"A", "B", "C"}; !reflect.DeepEqual(path, expected) {
+		t.Errorf("expected path %v, got %v", expected, path)
+	}
+	if cost != 2 {
+		t.Errorf("expected cost 2, got %v", cost)
+	}
+}
@@ -0,0 +1,66 @@
+package a_star
+
+import (
+	"math"
+
+	"github.com/sosalejandro/algo-practice/graph"
+)
+
+// Point is a cell of a 2D grid.
+type Point struct {
+	X, Y int
+}
+
+// Manhattan returns the number of horizontal and vertical steps between a and b.
+// It is admissible on grids where moves are horizontal or vertical and cost at least one.
+func Manhattan[W graph.Weight](a, b Point) W {
+	return W(abs(a.X-b.X) + abs(a.Y-b.Y))
+}
+
+// Euclidean returns the straight-line distance between a and b.
+// It is admissible on any grid where a move costs at least the distance it covers,
+// which rules out the diagonal moves of GridNeighbors since they cost one.
+// For integer weights the distance is rounded down, which keeps it admissible.
+func Euclidean[W graph.Weight](a, b Point) W {
+	return W(math.Hypot(float64(a.X-b.X), float64(a.Y-b.Y)))
+}
+
+// Chebyshev returns the number of king moves between a and b.
+// It is admissible on grids where diagonal moves are allowed and every move costs at least one.
+func Chebyshev[W graph.Weight](a, b Point) W {
+	return W(max(abs(a.X-b.X), abs(a.Y-b.Y)))
+}
+
+// GridNeighbors returns a NeighborsFunc for a width by height grid where every move costs one.
+// Cells outside the grid or for which blocked returns true are never returned as neighbors;
+// blocked may be nil if every cell is open.
+// With diagonal set, the eight surrounding cells are neighbors and only Chebyshev is an admissible heuristic;
+// otherwise only the four orthogonal ones are, and Manhattan, Euclidean and Chebyshev are all admissible.
+func GridNeighbors[W graph.Weight](width, height int, blocked func(p Point) bool, diagonal bool) NeighborsFunc[Point, W] {
+	directions := []Point{{X: 0, Y: -1}, {X: 1, Y: 0}, {X: 0, Y: 1}, {X: -1, Y: 0}}
+	if diagonal {
+		directions = append(directions, Point{X: 1, Y: -1}, Point{X: 1, Y: 1}, Point{X: -1, Y: 1}, Point{X: -1, Y: -1})
+	}
+
+	return func(p Point) []graph.Neighbor[Point, W] {
+		result := make([]graph.Neighbor[Point, W], 0, len(directions))
+		for _, direction := range directions {
+			next := Point{X: p.X + direction.X, Y: p.Y + direction.Y}
+			if next.X < 0 || next.Y < 0 || next.X >= width || next.Y >= height {
+				continue
+			}
+			if blocked != nil && blocked(next) {
+				continue
+			}
+			result = append(result, graph.Neighbor[Point, W]{Node: next, Weight: 1})
+		}
+		return result
+	}
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
@@ -0,0 +1,147 @@
+package a_star
+
+import (
+	"testing"
+
+	"github.com/sosalejandro/algo-practice/graph"
+)
+
+// parseGrid reads a grid where '#' marks a blocked cell, 'S' the start and 'G' the goal.
+func parseGrid(rows []string) (width, height int, blocked func(Point) bool, start, goal Point) {
+	walls := make(map[Point]bool)
+	for y, row := range rows {
+		for x, cell := range row {
+			switch cell {
+			case '#':
+				walls[Point{X: x, Y: y}] = true
+			case 'S':
+				start = Point{X: x, Y: y}
+			case 'G':
+				goal = Point{X: x, Y: y}
+			}
+		}
+	}
+	return len(rows[0]), len(rows), func(p Point) bool { return walls[p] }, start, goal
+}
+
+func TestHeuristics(t *testing.T) {
+	a, b := Point{X: 1, Y: 1}, Point{X: 4, Y: 5}
+
+	if got := Manhattan[int](a, b); got != 7 {
+		t.Errorf("expected Manhattan distance 7, got %v", got)
+	}
+	if got := Euclidean[float64](a, b); got != 5 {
+		t.Errorf("expected Euclidean distance 5, got %v", got)
+	}
+	if got := Euclidean[int](a, Point{X: 2, Y: 2}); got != 1 {
+		t.Errorf("expected integer Euclidean distance to round down to 1, got %v", got)
+	}
+	if got := Chebyshev[int](a, b); got != 4 {
+		t.Errorf("expected Chebyshev distance 4, got %v", got)
+	}
+}
+
+func TestSearch_Grid(t *testing.T) {
+	tests := []struct {
+		name      string
+		grid      []string
+		diagonal  bool
+		heuristic Heuristic[Point, int]
+		cost      int
+		found     bool
+	}{
+		{
+			name:      "Open Grid Orthogonal",
+			grid:      []string{"S....", ".....", "....G"},
+			heuristic: Manhattan[int],
+			cost:      6,
+			found:     true,
+		},
+		{
+			name:      "Open Grid Diagonal",
+			grid:      []string{"S....", ".....", "....G"},
+			diagonal:  true,
+			heuristic: Chebyshev[int],
+			cost:      4,
+			found:     true,
+		},
+		{
+			name:      "Wall Forces Detour",
+			grid:      []string{"S.#..", "..#..", "..#.G", "....."},
+			heuristic: Manhattan[int],
+			cost:      8,
+			found:     true,
+		},
+		{
+			name:      "Euclidean Around Wall",
+			grid:      []string{"S.#..", "..#..", "..#.G", "....."},
+			heuristic: Euclidean[int],
+			cost:      8,
+			found:     true,
+		},
+		{
+			name:      "Chebyshev Around Wall",
+			grid:      []string{"S.#..", "..#..", "..#.G", "....."},
+			diagonal:  true,
+			heuristic: Chebyshev[int],
+			cost:      5,
+			found:     true,
+		},
+		{
+			name:      "Goal Walled Off",
+			grid:      []string{"S.#..", "..#..", "..#.G", "..#.."},
+			heuristic: Manhattan[int],
+			found:     false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			width, height, blocked, start, goal := parseGrid(tt.grid)
+			neighbors := GridNeighbors[int](width, height, blocked, tt.diagonal)
+
+			path, cost, found := Search(neighbors, start, goal, tt.heuristic)
+			if found != tt.found {
+				t.Fatalf("expected found %v, got %v", tt.found, found)
+			}
+			if !found {
+				return
+			}
+			if cost != tt.cost {
+				t.Errorf("expected cost %v, got %v", tt.cost, cost)
+			}
+			if len(path) != tt.cost+1 || path[0] != start || path[len(path)-1] != goal {
+				t.Errorf("expected a path of %d steps from %v to %v, got %v", tt.cost, start, goal, path)
+			}
+			for _, p := range path {
+				if blocked(p) {
+					t.Errorf("expected path to avoid walls, got %v", path)
+				}
+			}
+		})
+	}
+}
+
+func TestSearch_ExpandsFewerNodesThanUninformedSearch(t *testing.T) {
+	width, height := 50, 50
+	start, goal := Point{X: 0, Y: 0}, Point{X: 49, Y: 0}
+	grid := GridNeighbors[int](width, height, nil, false)
+
+	expand := func(heuristic Heuristic[Point, int]) int {
+		expanded := 0
+		counting := func(p Point) []graph.Neighbor[Point, int] {
+			expanded++
+			return grid(p)
+		}
+		if _, _, found := Search(counting, start, goal, heuristic); !found {
+			t.Fatalf("expected a path")
+		}
+		return expanded
+	}
+
+	informed := expand(Manhattan[int])
+	uninformed := expand(zeroHeuristic[Point])
+	if informed*10 > uninformed {
+		t.Errorf("expected Manhattan to expand far fewer nodes than no heuristic, got %d and %d", informed, uninformed)
+	}
+}
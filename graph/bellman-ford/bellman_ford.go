@@ -0,0 +1,151 @@
+package bellman_ford
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/sosalejandro/algo-practice/data-structures/common"
+	"github.com/sosalejandro/algo-practice/graph"
+)
+
+// NegativeCycleError is returned when a cycle whose total weight is negative can be reached from the source,
+// in which case no shortest path exists for the nodes it leads to.
+// Cycle lists the nodes of the cycle in edge order, without repeating the first node at the end.
+type NegativeCycleError[T comparable] struct {
+	Cycle []T
+}
+
+func (e *NegativeCycleError[T]) Error() string {
+	return fmt.Sprintf("negative cycle %v", e.Cycle)
+}
+
+// BellmanFord finds the cheapest path from src to every node reachable from it.
+// Unlike Dijkstra's algorithm it accepts negative edge weights, at the cost of O(V·E) time.
+// If src is not in the graph the tree is empty.
+// It returns a *NegativeCycleError if a negative cycle is reachable from src.
+// Note that in a Bidirectional graph a single negative edge is already a negative cycle.
+func BellmanFord[T comparable, W graph.Weight](g *graph.WeightedGraph[T, W], src T) (*graph.ShortestPathTree[T, W], error) {
+	tree := graph.NewShortestPathTree[T, W](src)
+	if !g.HasNode(src) {
+		return tree, nil
+	}
+	tree.Distances[src] = 0
+
+	nodes := g.Nodes()
+
+	// After i passes every path of up to i edges has been relaxed, so V-1 passes are enough
+	// unless a negative cycle keeps lowering distances.
+	for pass := 0; pass < len(nodes); pass++ {
+		relaxed := false
+		for _, node := range nodes {
+			distance, reached := tree.Distances[node]
+			if !reached {
+				continue
+			}
+
+			for _, neighbor := range g.Neighbors(node) {
+				current, reachedNeighbor := tree.Distances[neighbor.Node]
+				if reachedNeighbor && distance+neighbor.Weight >= current {
+					continue
+				}
+
+				tree.Distances[neighbor.Node] = distance + neighbor.Weight
+				tree.Predecessors[neighbor.Node] = node
+				relaxed = true
+
+				// A relaxation in the V-th pass means a negative cycle leads to the neighbor
+				if pass == len(nodes)-1 {
+					return nil, &NegativeCycleError[T]{Cycle: findCycle(tree.Predecessors, neighbor.Node)}
+				}
+			}
+		}
+
+		if !relaxed {
+			break
+		}
+	}
+
+	return tree, nil
+}
+
+// SPFA (Shortest Path Faster Algorithm) finds the same shortest paths as BellmanFord,
+// but only re-relaxes the edges of nodes whose distance changed, which it tracks with a graph.QueueTransporter.
+// It is usually much faster than BellmanFord, with the same O(V·E) worst case.
+// The itemFactory parameter is used to create items for the transporter.
+// It returns a *NegativeCycleError if a negative cycle is reachable from src,
+// or the transporter's error, such as common.ErrInvalidItem when itemFactory produces an empty item for a node.
+func SPFA[T comparable, W graph.Weight](g *graph.WeightedGraph[T, W], src T, itemFactory common.ItemFactory[T]) (*graph.ShortestPathTree[T, W], error) {
+	tree := graph.NewShortestPathTree[T, W](src)
+	if !g.HasNode(src) {
+		return tree, nil
+	}
+	tree.Distances[src] = 0
+
+	transporter := graph.NewQueueTransporter[T](itemFactory)
+	if err := transporter.Add(src); err != nil {
+		return nil, err
+	}
+
+	queued := map[T]bool{src: true}
+	// edges counts the edges on the current shortest path to a node; a simple path has fewer than V.
+	edges := map[T]int{src: 0}
+
+	for !transporter.IsEmpty() {
+		currentItem, err := transporter.Next()
+		if err != nil {
+			return nil, err
+		}
+		current := currentItem.Value()
+		queued[current] = false
+
+		distance := tree.Distances[current]
+		for _, neighbor := range g.Neighbors(current) {
+			existing, reached := tree.Distances[neighbor.Node]
+			if reached && distance+neighbor.Weight >= existing {
+				continue
+			}
+
+			tree.Distances[neighbor.Node] = distance + neighbor.Weight
+			tree.Predecessors[neighbor.Node] = current
+			edges[neighbor.Node] = edges[current] + 1
+
+			if edges[neighbor.Node] >= g.NodeCount() {
+				if cycle := findCycle(tree.Predecessors, neighbor.Node); cycle != nil {
+					return nil, &NegativeCycleError[T]{Cycle: cycle}
+				}
+			}
+
+			if !queued[neighbor.Node] {
+				queued[neighbor.Node] = true
+				if err := transporter.Add(neighbor.Node); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+
+	return tree, nil
+}
+
+// findCycle follows the predecessors of start until a node repeats and returns the cycle it closed,
+// in edge order. It returns nil if the predecessors lead back to the source without repeating.
+func findCycle[T comparable](predecessors map[T]T, start T) []T {
+	position := make(map[T]int)
+	walk := make([]T, 0)
+
+	for node := start; ; {
+		if i, seen := position[node]; seen {
+			cycle := slices.Clone(walk[i:])
+			slices.Reverse(cycle)
+			return cycle
+		}
+		position[node] = len(walk)
+		walk = append(walk, node)
+
+		predecessor, exists := predecessors[node]
+		if !exists {
+			return nil
+		}
+		node = predecessor
+	}
+}
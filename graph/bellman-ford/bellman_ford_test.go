@@ -0,0 +1,197 @@
+package bellman_ford
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/sosalejandro/algo-practice/data-structures/common"
+	"github.com/sosalejandro/algo-practice/graph"
+)
+
+type shortestPathFunc func(g *graph.WeightedGraph[string, int], src string) (*graph.ShortestPathTree[string, int], error)
+
+var algorithms = []struct {
+	name string
+	run  shortestPathFunc
+}{
+	{name: "BellmanFord", run: BellmanFord[string, int]},
+	{name: "SPFA", run: func(g *graph.WeightedGraph[string, int], src string) (*graph.ShortestPathTree[string, int], error) {
+		return SPFA(g, src, common.ValueItemFactory[string])
+	}},
+}
+
+func TestShortestPaths(t *testing.T) {
+	tests := []struct {
+		name      string
+		edges     []graph.WeightedEdge[string, int]
+		src       string
+		distances map[string]int
+		paths     map[string][]string
+	}{
+		{
+			name:      "Unknown Source",
+			edges:     []graph.WeightedEdge[string, int]{{Src: "A", Dst: "B", Weight: 1}},
+			src:       "Z",
+			distances: map[string]int{},
+			paths:     map[string][]string{},
+		},
+		{
+			name: "Positive Weights",
+			edges: []graph.WeightedEdge[string, int]{
+				{Src: "A", Dst: "B", Weight: 4},
+				{Src: "A", Dst: "C", Weight: 1},
+				{Src: "C", Dst: "B", Weight: 2},
+				{Src: "B", Dst: "D", Weight: 1},
+			},
+			src:       "A",
+			distances: map[string]int{"A": 0, "B": 3, "C": 1, "D": 4},
+			paths:     map[string][]string{"D": {"A", "C", "B", "D"}},
+		},
+		{
+			name: "Negative Rebate Makes Longer Path Cheaper",
+			edges: []graph.WeightedEdge[string, int]{
+				{Src: "A", Dst: "B", Weight: 5},
+				{Src: "A", Dst: "C", Weight: 2},
+				{Src: "C", Dst: "D", Weight: 3},
+				{Src: "D", Dst: "B", Weight: -4},
+				{Src: "B", Dst: "E", Weight: 1},
+			},
+			src:       "A",
+			distances: map[string]int{"A": 0, "B": 1, "C": 2, "D": 5, "E": 2},
+			paths:     map[string][]string{"E": {"A", "C", "D", "B", "E"}},
+		},
+		{
+			name: "Unreachable Negative Cycle Is Ignored",
+			edges: []graph.WeightedEdge[string, int]{
+				{Src: "A", Dst: "B", Weight: 1},
+				{Src: "X", Dst: "Y", Weight: -1},
+				{Src: "Y", Dst: "X", Weight: -1},
+			},
+			src:       "A",
+			distances: map[string]int{"A": 0, "B": 1},
+			paths:     map[string][]string{"B": {"A", "B"}},
+		},
+	}
+
+	for _, algorithm := range algorithms {
+		for _, tt := range tests {
+			t.Run(algorithm.name+"/"+tt.name, func(t *testing.T) {
+				g := graph.GenerateWeightedGraphFromEdges(tt.edges, graph.Directional)
+
+				tree, err := algorithm.run(g, tt.src)
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if !reflect.DeepEqual(tree.Distances, tt.distances) {
+					t.Errorf("expected distances %v, got %v", tt.distances, tree.Distances)
+				}
+				for dst, expected := range tt.paths {
+					if path, _ := tree.PathTo(dst); !reflect.DeepEqual(path, expected) {
+						t.Errorf("expected path to %v to be %v, got %v", dst, expected, path)
+					}
+				}
+			})
+		}
+	}
+}
+
+func TestShortestPaths_NegativeCycle(t *testing.T) {
+	tests := []struct {
+		name      string
+		edges     []graph.WeightedEdge[string, int]
+		graphType graph.GraphType
+		src       string
+		cycle     []string
+	}{
+		{
+			name: "Directed Cycle",
+			edges: []graph.WeightedEdge[string, int]{
+				{Src: "A", Dst: "B", Weight: 1},
+				{Src: "B", Dst: "C", Weight: 2},
+				{Src: "C", Dst: "D", Weight: -4},
+				{Src: "D", Dst: "B", Weight: 1},
+				{Src: "D", Dst: "E", Weight: 1},
+			},
+			graphType: graph.Directional,
+			src:       "A",
+			cycle:     []string{"B", "C", "D"},
+		},
+		{
+			name:      "Self Loop",
+			edges:     []graph.WeightedEdge[string, int]{{Src: "A", Dst: "A", Weight: -1}},
+			graphType: graph.Directional,
+			src:       "A",
+			cycle:     []string{"A"},
+		},
+		{
+			name: "Undirected Negative Edge",
+			edges: []graph.WeightedEdge[string, int]{
+				{Src: "A", Dst: "B", Weight: 2},
+				{Src: "B", Dst: "C", Weight: -1},
+			},
+			graphType: graph.Bidirectional,
+			src:       "A",
+			cycle:     []string{"B", "C"},
+		},
+	}
+
+	for _, algorithm := range algorithms {
+		for _, tt := range tests {
+			t.Run(algorithm.name+"/"+tt.name, func(t *testing.T) {
+				g := graph.GenerateWeightedGraphFromEdges(tt.edges, tt.graphType)
+
+				_, err := algorithm.run(g, tt.src)
+
+				var cycleErr *NegativeCycleError[string]
+				if !errors.As(err, &cycleErr) {
+					t.Fatalf("expected a NegativeCycleError, got %v", err)
+				}
+				if !sameCycle(cycleErr.Cycle, tt.cycle) {
+					t.Errorf("expected cycle %v, got %v", tt.cycle, cycleErr.Cycle)
+				}
+				if !isNegativeCycle(g, cycleErr.Cycle) {
+					t.Errorf("expected cycle %v to have a negative total weight", cycleErr.Cycle)
+				}
+			})
+		}
+	}
+}
+
+func TestSPFA_InvalidItem(t *testing.T) {
+	g := graph.GenerateWeightedGraphFromEdges([]graph.WeightedEdge[string, int]{
+		{Src: "A", Dst: "", Weight: 1},
+	}, graph.Directional)
+
+	_, err := SPFA(g, "A", common.NonZeroItemFactory[string])
+	if !errors.Is(err, common.ErrInvalidItem) {
+		t.Errorf("expected %v, got %v", common.ErrInvalidItem, err)
+	}
+}
+
+// sameCycle checks if got is a rotation of expected, since a cycle may be reported from any of its nodes.
+func sameCycle(got, expected []string) bool {
+	if len(got) != len(expected) {
+		return false
+	}
+	for shift := range got {
+		rotated := append(append([]string{}, got[shift:]...), got[:shift]...)
+		if reflect.DeepEqual(rotated, expected) {
+			return true
+		}
+	}
+	return false
+}
+
+// isNegativeCycle checks that every consecutive pair of nodes in cycle is joined by an edge and that the total weight is negative.
+func isNegativeCycle(g *graph.WeightedGraph[string, int], cycle []string) bool {
+	total := 0
+	for i, node := range cycle {
+		weight, exists := g.Weight(node, cycle[(i+1)%len(cycle)])
+		if !exists {
+			return false
+		}
+		total += weight
+	}
+	return total < 0
+}
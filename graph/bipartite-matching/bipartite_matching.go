@@ -0,0 +1,292 @@
+package bipartite_matching
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/sosalejandro/algo-practice/data-structures/common"
+	"github.com/sosalejandro/algo-practice/graph"
+	"github.com/sosalejandro/algo-practice/graph/bipartite"
+)
+
+// ErrNotBipartite is returned when the graph can't be split into two sides.
+var ErrNotBipartite = errors.New("graph is not bipartite")
+
+// Matching is a maximum matching of a bipartite graph.
+type Matching[T cmp.Ordered] struct {
+	// Pairs holds every matched edge as a {left, right} pair, sorted by the left node.
+	Pairs [][]T
+	// Size is the number of matched pairs.
+	Size int
+	// VertexCover is a minimum set of nodes touching every edge, in ascending order.
+	// By König's theorem it has exactly Size nodes, which certifies that the matching is maximum.
+	VertexCover []T
+}
+
+// HopcroftKarp finds a maximum matching of a bipartite graph with the Hopcroft-Karp algorithm,
+// which augments along a maximal set of shortest disjoint augmenting paths per phase and runs in O(E·√V) time.
+// The graph (g) is represented as an adjacency list, such as the one built by graph.GenerateGraphFromEdges;
+// edges are treated as undirected, so missing reverse edges are assumed.
+// The two sides are found with bipartite.IsBipartite, which puts the smallest node of every connected component
+// on the left, so the orientation of Pairs may differ between components;
+// use HopcroftKarpWithLeft to choose the left side.
+// It returns an error wrapping ErrNotBipartite, with the odd cycle found, if the graph is not bipartite.
+func HopcroftKarp[T cmp.Ordered](g map[T][]T) (*Matching[T], error) {
+	g = undirected(g)
+
+	coloring, err := bipartite.IsBipartite(graph.QueueTraversal, g, common.ValueItemFactory[T])
+	if err != nil {
+		return nil, err
+	}
+	if !coloring.Bipartite {
+		return nil, fmt.Errorf("%w: odd cycle %v", ErrNotBipartite, coloring.OddCycle)
+	}
+
+	return match(g, coloring.Left)
+}
+
+// HopcroftKarpWithLeft is like HopcroftKarp but takes the left side from the caller, so Pairs are always
+// reported as {left, right}. Every node of g not in left is on the right.
+// The graph (g) may be undirected, or directed from the left side to the right one,
+// such as a map from jobs to the workers able to do them built with graph.Directional.
+// It returns an error wrapping ErrNotBipartite if an edge joins two nodes of the same side.
+func HopcroftKarpWithLeft[T cmp.Ordered](g map[T][]T, left []T) (*Matching[T], error) {
+	left = slices.Clone(left)
+	slices.Sort(left)
+	left = slices.Compact(left)
+
+	nodes := make([]T, 0, len(g))
+	for node := range g {
+		nodes = append(nodes, node)
+	}
+	slices.Sort(nodes)
+
+	for _, u := range nodes {
+		_, uIsLeft := slices.BinarySearch(left, u)
+		for _, v := range g[u] {
+			if _, vIsLeft := slices.BinarySearch(left, v); uIsLeft == vIsLeft {
+				return nil, fmt.Errorf("%w: edge %v - %v joins two nodes of the same side", ErrNotBipartite, u, v)
+			}
+		}
+	}
+
+	return match(g, left)
+}
+
+// match runs Hopcroft-Karp between the sorted left nodes and their neighbors in g.
+// Only the adjacency lists of left nodes are followed.
+func match[T cmp.Ordered](g map[T][]T, left []T) (*Matching[T], error) {
+	matcher := &matcher[T]{
+		g:      g,
+		left:   left,
+		mateOf: make(map[T]T, len(g)),
+	}
+	for {
+		found, err := matcher.layer()
+		if err != nil {
+			return nil, err
+		}
+		if !found {
+			break
+		}
+		for _, u := range matcher.left {
+			if _, matched := matcher.mateOf[u]; !matched {
+				matcher.augment(u)
+			}
+		}
+	}
+
+	matching := &Matching[T]{Pairs: make([][]T, 0)}
+	for _, u := range matcher.left {
+		if v, matched := matcher.mateOf[u]; matched {
+			matching.Pairs = append(matching.Pairs, []T{u, v})
+		}
+	}
+	matching.Size = len(matching.Pairs)
+
+	cover, err := matcher.vertexCover()
+	if err != nil {
+		return nil, err
+	}
+	matching.VertexCover = cover
+	return matching, nil
+}
+
+// undirected returns a copy of g with the reverse of every edge added where it is missing.
+// Reverse edges are added in ascending order of their source, so the result doesn't depend on map iteration.
+func undirected[T cmp.Ordered](g map[T][]T) map[T][]T {
+	nodes := make([]T, 0, len(g))
+	for node := range g {
+		nodes = append(nodes, node)
+	}
+	slices.Sort(nodes)
+
+	result := make(map[T][]T, len(g))
+	linked := make(map[T]map[T]bool, len(g))
+	link := func(u, v T) {
+		if linked[u] == nil {
+			linked[u] = make(map[T]bool)
+		}
+		if _, exists := result[u]; !exists {
+			result[u] = make([]T, 0)
+		}
+		if !linked[u][v] {
+			linked[u][v] = true
+			result[u] = append(result[u], v)
+		}
+	}
+
+	for _, u := range nodes {
+		if _, exists := result[u]; !exists {
+			result[u] = make([]T, 0)
+		}
+		for _, v := range g[u] {
+			link(u, v)
+		}
+	}
+	for _, u := range nodes {
+		for _, v := range g[u] {
+			link(v, u)
+		}
+	}
+	return result
+}
+
+// matcher holds the state of a Hopcroft-Karp run.
+type matcher[T cmp.Ordered] struct {
+	g    map[T][]T
+	left []T
+	// mateOf maps every matched node, on either side, to its partner.
+	mateOf map[T]T
+	// distance holds the layer of every left node reached by the last call to layer,
+	// and limit the layer at which the shortest augmenting paths end.
+	distance map[T]int
+	limit    int
+}
+
+// layer runs a breadth-first search from every free left node, alternating unmatched and matched edges,
+// and labels left nodes with their layer. It reports whether any free right node, and so an augmenting path, was found.
+func (m *matcher[T]) layer() (bool, error) {
+	m.distance = make(map[T]int, len(m.left))
+	m.limit = -1
+
+	transporter := graph.NewQueueTransporter(common.ValueItemFactory[T])
+	for _, u := range m.left {
+		if _, matched := m.mateOf[u]; !matched {
+			m.distance[u] = 0
+			if err := transporter.Add(u); err != nil {
+				return false, err
+			}
+		}
+	}
+
+	for !transporter.IsEmpty() {
+		currentItem, err := transporter.Next()
+		if err != nil {
+			return false, err
+		}
+		u := currentItem.Value()
+
+		// Paths longer than the shortest augmenting ones are left for later phases
+		if m.limit >= 0 && m.distance[u] >= m.limit {
+			continue
+		}
+
+		for _, v := range m.g[u] {
+			w, matched := m.mateOf[v]
+			if !matched {
+				if m.limit < 0 {
+					m.limit = m.distance[u]
+				}
+				continue
+			}
+			if _, labeled := m.distance[w]; !labeled {
+				m.distance[w] = m.distance[u] + 1
+				if err := transporter.Add(w); err != nil {
+					return false, err
+				}
+			}
+		}
+	}
+
+	return m.limit >= 0, nil
+}
+
+// augment looks for a shortest augmenting path from the left node u following the layers,
+// and flips the matching along it if there is one. Dead ends are removed from the layers
+// so later searches in the same phase skip them.
+func (m *matcher[T]) augment(u T) bool {
+	for _, v := range m.g[u] {
+		w, matched := m.mateOf[v]
+		if !matched {
+			if m.distance[u] != m.limit {
+				continue
+			}
+		} else if distance, labeled := m.distance[w]; !labeled || distance != m.distance[u]+1 || !m.augment(w) {
+			continue
+		}
+
+		m.mateOf[u] = v
+		m.mateOf[v] = u
+		return true
+	}
+
+	delete(m.distance, u)
+	return false
+}
+
+// vertexCover builds a minimum vertex cover from the maximum matching using König's construction:
+// with Z the nodes reachable from free left nodes by alternating paths, the cover is the left nodes
+// outside Z and the right nodes inside it.
+func (m *matcher[T]) vertexCover() ([]T, error) {
+	reached := make(map[T]bool)
+	transporter := graph.NewQueueTransporter(common.ValueItemFactory[T])
+	for _, u := range m.left {
+		if _, matched := m.mateOf[u]; !matched {
+			reached[u] = true
+			if err := transporter.Add(u); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	for !transporter.IsEmpty() {
+		currentItem, err := transporter.Next()
+		if err != nil {
+			return nil, err
+		}
+		u := currentItem.Value()
+
+		// From the left only unmatched edges lead out, and from the right only the matched one comes back
+		for _, v := range m.g[u] {
+			if mate, matched := m.mateOf[u]; reached[v] || (matched && mate == v) {
+				continue
+			}
+			reached[v] = true
+			if w, matched := m.mateOf[v]; matched && !reached[w] {
+				reached[w] = true
+				if err := transporter.Add(w); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+
+	cover := make([]T, 0)
+	isLeft := make(map[T]bool, len(m.left))
+	for _, u := range m.left {
+		isLeft[u] = true
+		if !reached[u] {
+			cover = append(cover, u)
+		}
+	}
+	for v := range reached {
+		if !isLeft[v] {
+			cover = append(cover, v)
+		}
+	}
+	slices.Sort(cover)
+	return cover, nil
+}
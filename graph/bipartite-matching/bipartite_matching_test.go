@@ -0,0 +1,262 @@
+package bipartite_matching
+
+import (
+	"errors"
+	"math/rand"
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/sosalejandro/algo-practice/graph"
+)
+
+func TestHopcroftKarp(t *testing.T) {
+	tests := []struct {
+		name  string
+		edges [][]string
+		pairs [][]string
+		cover []string
+	}{
+		{
+			name:  "Empty Graph",
+			edges: [][]string{},
+			pairs: [][]string{},
+			cover: []string{},
+		},
+		{
+			name:  "Perfect Matching Needs Augmenting",
+			edges: [][]string{{"a", "x"}, {"a", "y"}, {"b", "x"}},
+			pairs: [][]string{{"a", "y"}, {"b", "x"}},
+			cover: []string{"a", "b"},
+		},
+		{
+			name: "Jobs And Workers",
+			edges: [][]string{
+				{"alice", "build"}, {"alice", "deploy"},
+				{"bob", "build"},
+				{"carol", "build"}, {"carol", "review"}, {"carol", "test"},
+				{"dave", "deploy"},
+			},
+			pairs: [][]string{{"alice", "build"}, {"carol", "review"}, {"dave", "deploy"}},
+			cover: []string{"build", "carol", "deploy"},
+		},
+		{
+			name:  "Star Matches Once",
+			edges: [][]string{{"hub", "a"}, {"hub", "b"}, {"hub", "c"}},
+			pairs: [][]string{{"a", "hub"}},
+			cover: []string{"hub"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := graph.GenerateGraphFromEdges(tt.edges, graph.Bidirectional)
+
+			matching, err := HopcroftKarp(g)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(matching.Pairs, tt.pairs) {
+				t.Errorf("expected pairs %v, got %v", tt.pairs, matching.Pairs)
+			}
+			if matching.Size != len(tt.pairs) {
+				t.Errorf("expected size %v, got %v", len(tt.pairs), matching.Size)
+			}
+			if !reflect.DeepEqual(matching.VertexCover, tt.cover) {
+				t.Errorf("expected vertex cover %v, got %v", tt.cover, matching.VertexCover)
+			}
+			checkMatching(t, g, matching)
+		})
+	}
+}
+
+func TestHopcroftKarp_NotBipartite(t *testing.T) {
+	g := graph.GenerateGraphFromEdges([][]string{{"a", "b"}, {"b", "c"}, {"c", "a"}}, graph.Bidirectional)
+
+	if _, err := HopcroftKarp(g); !errors.Is(err, ErrNotBipartite) {
+		t.Errorf("expected %v, got %v", ErrNotBipartite, err)
+	}
+}
+
+func TestHopcroftKarp_Directional(t *testing.T) {
+	g := graph.GenerateGraphFromEdges([][]string{{"j1", "w2"}, {"j2", "a"}, {"j3", "a"}, {"j3", "w2"}}, graph.Directional)
+
+	matching, err := HopcroftKarp(g)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if expected := [][]string{{"a", "j2"}, {"w2", "j1"}}; !reflect.DeepEqual(matching.Pairs, expected) {
+		t.Errorf("expected pairs %v, got %v", expected, matching.Pairs)
+	}
+	if expected := []string{"a", "w2"}; !reflect.DeepEqual(matching.VertexCover, expected) {
+		t.Errorf("expected vertex cover %v, got %v", expected, matching.VertexCover)
+	}
+}
+
+func TestHopcroftKarpWithLeft(t *testing.T) {
+	tests := []struct {
+		name      string
+		edges     [][]string
+		graphType graph.GraphType
+		left      []string
+		pairs     [][]string
+		cover     []string
+	}{
+		{
+			name:      "Jobs To Workers",
+			edges:     [][]string{{"j1", "w2"}, {"j2", "a"}},
+			graphType: graph.Directional,
+			left:      []string{"j1", "j2"},
+			pairs:     [][]string{{"j1", "w2"}, {"j2", "a"}},
+			cover:     []string{"j1", "j2"},
+		},
+		{
+			name: "Jobs To Workers Needs Augmenting",
+			edges: [][]string{
+				{"alice", "build"}, {"alice", "deploy"},
+				{"bob", "build"},
+				{"carol", "build"}, {"carol", "review"}, {"carol", "test"},
+				{"dave", "deploy"},
+			},
+			graphType: graph.Directional,
+			left:      []string{"alice", "bob", "carol", "dave"},
+			pairs:     [][]string{{"alice", "build"}, {"carol", "review"}, {"dave", "deploy"}},
+			cover:     []string{"build", "carol", "deploy"},
+		},
+		{
+			name:      "Undirected Star From The Leaves",
+			edges:     [][]string{{"hub", "a"}, {"hub", "b"}, {"hub", "c"}},
+			graphType: graph.Bidirectional,
+			left:      []string{"c", "b", "a"},
+			pairs:     [][]string{{"a", "hub"}},
+			cover:     []string{"hub"},
+		},
+		{
+			name:      "Undirected Star From The Hub",
+			edges:     [][]string{{"hub", "a"}, {"hub", "b"}, {"hub", "c"}},
+			graphType: graph.Bidirectional,
+			left:      []string{"hub"},
+			pairs:     [][]string{{"hub", "a"}},
+			cover:     []string{"hub"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := graph.GenerateGraphFromEdges(tt.edges, tt.graphType)
+
+			matching, err := HopcroftKarpWithLeft(g, tt.left)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(matching.Pairs, tt.pairs) {
+				t.Errorf("expected pairs %v, got %v", tt.pairs, matching.Pairs)
+			}
+			if !reflect.DeepEqual(matching.VertexCover, tt.cover) {
+				t.Errorf("expected vertex cover %v, got %v", tt.cover, matching.VertexCover)
+			}
+			checkMatching(t, g, matching)
+		})
+	}
+}
+
+func TestHopcroftKarpWithLeft_NotBipartite(t *testing.T) {
+	g := graph.GenerateGraphFromEdges([][]string{{"j1", "w1"}, {"j1", "j2"}}, graph.Directional)
+
+	if _, err := HopcroftKarpWithLeft(g, []string{"j1", "j2"}); !errors.Is(err, ErrNotBipartite) {
+		t.Errorf("expected %v, got %v", ErrNotBipartite, err)
+	}
+}
+
+func TestHopcroftKarp_ZeroValueNodes(t *testing.T) {
+	g := graph.GenerateGraphFromEdges([][]int{{0, 10}, {1, 10}, {1, 11}}, graph.Bidirectional)
+
+	matching, err := HopcroftKarp(g)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if expected := [][]int{{0, 10}, {1, 11}}; !reflect.DeepEqual(matching.Pairs, expected) {
+		t.Errorf("expected pairs %v, got %v", expected, matching.Pairs)
+	}
+}
+
+func TestHopcroftKarp_MatchesAugmentingPathSearch(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+
+	for round := range 50 {
+		edges := make([][]int, 0)
+		for left := range 12 {
+			for right := 100; right < 112; right++ {
+				if random.Intn(5) == 0 {
+					edges = append(edges, []int{left, right})
+				}
+			}
+		}
+		g := graph.GenerateGraphFromEdges(edges, graph.Bidirectional)
+
+		matching, err := HopcroftKarp(g)
+		if err != nil {
+			t.Fatalf("round %d: unexpected error: %v", round, err)
+		}
+		if expected := kuhn(g); matching.Size != expected {
+			t.Errorf("round %d: expected a matching of size %d, got %d", round, expected, matching.Size)
+		}
+		checkMatching(t, g, matching)
+	}
+}
+
+// kuhn returns the size of a maximum matching between nodes below 100 and nodes from 100 up,
+// found by searching one augmenting path at a time.
+func kuhn(g map[int][]int) int {
+	mateOf := make(map[int]int)
+	var try func(u int, seen map[int]bool) bool
+	try = func(u int, seen map[int]bool) bool {
+		for _, v := range g[u] {
+			if seen[v] {
+				continue
+			}
+			seen[v] = true
+			if w, matched := mateOf[v]; !matched || try(w, seen) {
+				mateOf[v] = u
+				return true
+			}
+		}
+		return false
+	}
+
+	size := 0
+	for u := range g {
+		if u < 100 && try(u, make(map[int]bool)) {
+			size++
+		}
+	}
+	return size
+}
+
+// checkMatching checks that the pairs are edges of g sharing no node,
+// and that the vertex cover touches every edge and is as large as the matching.
+func checkMatching[T int | string](t *testing.T, g map[T][]T, matching *Matching[T]) {
+	t.Helper()
+
+	used := make(map[T]bool)
+	for _, pair := range matching.Pairs {
+		if !slices.Contains(g[pair[0]], pair[1]) {
+			t.Errorf("expected pair %v to be an edge of the graph", pair)
+		}
+		if used[pair[0]] || used[pair[1]] {
+			t.Errorf("expected pair %v not to share nodes with other pairs", pair)
+		}
+		used[pair[0]], used[pair[1]] = true, true
+	}
+
+	if len(matching.VertexCover) != matching.Size {
+		t.Errorf("expected a vertex cover of size %d, got %v", matching.Size, matching.VertexCover)
+	}
+	for node, neighbors := range g {
+		for _, neighbor := range neighbors {
+			if !slices.Contains(matching.VertexCover, node) && !slices.Contains(matching.VertexCover, neighbor) {
+				t.Errorf("expected vertex cover %v to touch edge %v - %v", matching.VertexCover, node, neighbor)
+			}
+		}
+	}
+}
@@ -0,0 +1,145 @@
+package bipartite
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/sosalejandro/algo-practice/data-structures/common"
+	"github.com/sosalejandro/algo-practice/graph"
+)
+
+// Coloring is the result of a bipartite check.
+type Coloring[T cmp.Ordered] struct {
+	// Bipartite reports whether the nodes can be split in two sides with every edge joining both sides.
+	Bipartite bool
+	// Left and Right hold the two sides in ascending order when the graph is bipartite.
+	// The smallest node of every connected component is on the Left.
+	Left  []T
+	Right []T
+	// OddCycle holds a cycle with an odd number of edges when the graph is not bipartite, which proves
+	// no split exists. It lists the nodes in edge order starting from its smallest node, without repeating it.
+	OddCycle []T
+}
+
+// IsBipartite checks if an undirected graph can be two-colored, coloring every node the opposite of the node
+// it was discovered from while traversing the graph with the given strategy.
+// The graph (g) is represented as an adjacency list, such as the one built by
+// graph.GenerateGraphFromEdges with graph.Bidirectional. Neighbors missing from the adjacency list are ignored.
+// Edges of a one-directional adjacency list that lead into an earlier traversal tree are ignored as well,
+// so such a graph only reports an odd cycle when one closes within a single tree.
+// The itemFactory parameter is used to create items for the transporter.
+// The function returns an error if the transporter encounters an error, such as
+// common.ErrInvalidItem when itemFactory produces an empty item for a node.
+func IsBipartite[T cmp.Ordered](strategy graph.TraversalStrategy, g map[T][]T, itemFactory common.ItemFactory[T]) (*Coloring[T], error) {
+	transporter := graph.NewTransporter[T](strategy, itemFactory)
+	if transporter == nil {
+		return &Coloring[T]{}, nil
+	}
+
+	return IsBipartiteWithTransporter(transporter, g)
+}
+
+// IsBipartiteWithTransporter is like IsBipartite but traverses the graph with the given transporter.
+// The transporter should be empty.
+func IsBipartiteWithTransporter[T cmp.Ordered](transporter graph.Transporter[T], g map[T][]T) (*Coloring[T], error) {
+	nodes := make([]T, 0, len(g))
+	for node := range g {
+		nodes = append(nodes, node)
+	}
+	slices.Sort(nodes)
+
+	// A node's color is the parity of its depth in the traversal tree, so two nodes of the same color
+	// joined by an edge close a cycle of odd length through their common ancestor.
+	colors := make(map[T]bool, len(g))
+	parents := make(map[T]T, len(g))
+	// roots maps every colored node to the root of its traversal tree. Two trees can only be joined by
+	// an edge whose reverse is missing, and such an edge closes no cycle.
+	roots := make(map[T]T, len(g))
+
+	for _, root := range nodes {
+		if _, colored := colors[root]; colored {
+			continue
+		}
+
+		colors[root] = false
+		roots[root] = root
+		if err := transporter.Add(root); err != nil {
+			return nil, err
+		}
+
+		for !transporter.IsEmpty() {
+			currentItem, err := transporter.Next()
+			if err != nil {
+				return nil, err
+			}
+			current := currentItem.Value()
+
+			for _, neighbor := range g[current] {
+				// Check if the neighbor exists in the graph
+				if _, neighborExists := g[neighbor]; !neighborExists {
+					continue
+				}
+
+				color, colored := colors[neighbor]
+				if !colored {
+					colors[neighbor] = !colors[current]
+					parents[neighbor] = current
+					roots[neighbor] = root
+					if err := transporter.Add(neighbor); err != nil {
+						return nil, err
+					}
+					continue
+				}
+				if color == colors[current] && roots[neighbor] == root {
+					return &Coloring[T]{OddCycle: oddCycle(parents, current, neighbor)}, nil
+				}
+			}
+		}
+	}
+
+	coloring := &Coloring[T]{Bipartite: true, Left: make([]T, 0), Right: make([]T, 0)}
+	for _, node := range nodes {
+		if colors[node] {
+			coloring.Right = append(coloring.Right, node)
+		} else {
+			coloring.Left = append(coloring.Left, node)
+		}
+	}
+	return coloring, nil
+}
+
+// oddCycle returns the cycle made of the traversal tree paths from a and b to their closest common ancestor
+// and the edge between a and b, rotated to start from its smallest node.
+func oddCycle[T cmp.Ordered](parents map[T]T, a, b T) []T {
+	// Every ancestor of a, a included, in order
+	ancestorsOfA := []T{a}
+	position := map[T]int{a: 0}
+	for node := a; ; {
+		parent, exists := parents[node]
+		if !exists {
+			break
+		}
+		position[parent] = len(ancestorsOfA)
+		ancestorsOfA = append(ancestorsOfA, parent)
+		node = parent
+	}
+
+	// Walk up from b until reaching an ancestor of a
+	pathFromB := make([]T, 0)
+	node := b
+	for {
+		if _, shared := position[node]; shared {
+			break
+		}
+		pathFromB = append(pathFromB, node)
+		node = parents[node]
+	}
+
+	// a up to the common ancestor, then down to b
+	cycle := slices.Clone(ancestorsOfA[:position[node]+1])
+	slices.Reverse(pathFromB)
+	cycle = append(cycle, pathFromB...)
+
+	start := slices.Index(cycle, slices.Min(cycle))
+	return slices.Concat(cycle[start:], cycle[:start])
+}
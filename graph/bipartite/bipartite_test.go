@@ -0,0 +1,159 @@
+package bipartite
+
+import (
+	"errors"
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/sosalejandro/algo-practice/data-structures/common"
+	"github.com/sosalejandro/algo-practice/graph"
+)
+
+var strategies = map[string]graph.TraversalStrategy{
+	"DFS": graph.StackTraversal,
+	"BFS": graph.QueueTraversal,
+}
+
+func TestIsBipartite(t *testing.T) {
+	tests := []struct {
+		name  string
+		edges [][]string
+		left  []string
+		right []string
+	}{
+		{
+			name:  "Empty Graph",
+			edges: [][]string{},
+			left:  []string{},
+			right: []string{},
+		},
+		{
+			name:  "Even Cycle",
+			edges: [][]string{{"A", "B"}, {"B", "C"}, {"C", "D"}, {"D", "A"}},
+			left:  []string{"A", "C"},
+			right: []string{"B", "D"},
+		},
+		{
+			name:  "Tree",
+			edges: [][]string{{"A", "B"}, {"A", "C"}, {"C", "D"}, {"C", "E"}},
+			left:  []string{"A", "D", "E"},
+			right: []string{"B", "C"},
+		},
+		{
+			name:  "Several Components",
+			edges: [][]string{{"B", "A"}, {"X", "Y"}, {"Y", "Z"}},
+			left:  []string{"A", "X", "Z"},
+			right: []string{"B", "Y"},
+		},
+	}
+
+	for name, strategy := range strategies {
+		for _, tt := range tests {
+			t.Run(name+"/"+tt.name, func(t *testing.T) {
+				g := graph.GenerateGraphFromEdges(tt.edges, graph.Bidirectional)
+
+				coloring, err := IsBipartite(strategy, g, common.ValueItemFactory[string])
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if !coloring.Bipartite {
+					t.Fatalf("expected graph to be bipartite, got odd cycle %v", coloring.OddCycle)
+				}
+				if !reflect.DeepEqual(coloring.Left, tt.left) {
+					t.Errorf("expected left side %v, got %v", tt.left, coloring.Left)
+				}
+				if !reflect.DeepEqual(coloring.Right, tt.right) {
+					t.Errorf("expected right side %v, got %v", tt.right, coloring.Right)
+				}
+			})
+		}
+	}
+}
+
+func TestIsBipartite_OddCycle(t *testing.T) {
+	tests := []struct {
+		name  string
+		edges [][]string
+	}{
+		{name: "Triangle", edges: [][]string{{"A", "B"}, {"B", "C"}, {"C", "A"}}},
+		{name: "Self Loop", edges: [][]string{{"A", "B"}, {"B", "B"}}},
+		{
+			name: "Odd Cycle Behind Even One",
+			edges: [][]string{
+				{"A", "B"}, {"B", "C"}, {"C", "D"}, {"D", "A"},
+				{"C", "E"}, {"E", "F"}, {"F", "G"}, {"G", "H"}, {"H", "I"}, {"I", "E"},
+			},
+		},
+		{name: "Odd Cycle In Second Component", edges: [][]string{{"A", "B"}, {"X", "Y"}, {"Y", "Z"}, {"Z", "V"}, {"V", "W"}, {"W", "X"}}},
+	}
+
+	for name, strategy := range strategies {
+		for _, tt := range tests {
+			t.Run(name+"/"+tt.name, func(t *testing.T) {
+				g := graph.GenerateGraphFromEdges(tt.edges, graph.Bidirectional)
+
+				coloring, err := IsBipartite(strategy, g, common.ValueItemFactory[string])
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if coloring.Bipartite {
+					t.Fatalf("expected graph not to be bipartite, got %v and %v", coloring.Left, coloring.Right)
+				}
+				if len(coloring.OddCycle)%2 != 1 {
+					t.Errorf("expected a cycle of odd length, got %v", coloring.OddCycle)
+				}
+				if coloring.OddCycle[0] != slices.Min(coloring.OddCycle) {
+					t.Errorf("expected cycle to start from its smallest node, got %v", coloring.OddCycle)
+				}
+				for i, node := range coloring.OddCycle {
+					next := coloring.OddCycle[(i+1)%len(coloring.OddCycle)]
+					if !slices.Contains(g[node], next) {
+						t.Errorf("expected %v to follow edges of the graph, %v -> %v is missing", coloring.OddCycle, node, next)
+					}
+				}
+			})
+		}
+	}
+}
+
+func TestIsBipartite_Directional(t *testing.T) {
+	tests := []struct {
+		name      string
+		edges     [][]string
+		bipartite bool
+	}{
+		{name: "Edge Into Earlier Tree", edges: [][]string{{"j2", "a"}}, bipartite: true},
+		{name: "Edges Into Earlier Trees", edges: [][]string{{"j1", "w2"}, {"j2", "a"}, {"j3", "a"}, {"j3", "w2"}}, bipartite: true},
+		{name: "Directed Triangle", edges: [][]string{{"A", "B"}, {"B", "C"}, {"C", "A"}}, bipartite: false},
+	}
+
+	for name, strategy := range strategies {
+		for _, tt := range tests {
+			t.Run(name+"/"+tt.name, func(t *testing.T) {
+				g := graph.GenerateGraphFromEdges(tt.edges, graph.Directional)
+
+				coloring, err := IsBipartite(strategy, g, common.ValueItemFactory[string])
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if coloring.Bipartite != tt.bipartite {
+					t.Fatalf("expected bipartite to be %v, got %v", tt.bipartite, coloring.Bipartite)
+				}
+				if !tt.bipartite && len(coloring.OddCycle)%2 != 1 {
+					t.Errorf("expected a cycle of odd length, got %v", coloring.OddCycle)
+				}
+			})
+		}
+	}
+}
+
+func TestIsBipartite_InvalidItem(t *testing.T) {
+	g := graph.GenerateGraphFromEdges([][]string{{"A", ""}}, graph.Bidirectional)
+
+	for name, strategy := range strategies {
+		if _, err := IsBipartite(strategy, g, common.NonZeroItemFactory[string]); !errors.Is(err, common.ErrInvalidItem) {
+			t.Errorf("%s: expected %v, got %v", name, common.ErrInvalidItem, err)
+		}
+	}
+}
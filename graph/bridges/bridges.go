@@ -0,0 +1,148 @@
+package bridges
+
+import (
+	"cmp"
+	"slices"
+)
+
+// Analysis holds the critical edges and nodes of an undirected graph.
+// All slices are sorted so the result does not depend on map iteration order.
+type Analysis[T cmp.Ordered] struct {
+	// Bridges holds the edges whose removal disconnects the graph, each as a {smaller, larger} pair
+	// in the edge format of graph.GenerateGraphFromEdges.
+	Bridges [][]T
+	// ArticulationPoints holds the nodes whose removal disconnects the graph.
+	ArticulationPoints []T
+	// BiconnectedComponents holds the nodes of every maximal subgraph that stays connected after removing
+	// any single node. Components share their articulation points, and nodes without edges belong to none.
+	BiconnectedComponents [][]T
+}
+
+// Analyze finds the bridges, articulation points and biconnected components of an undirected graph
+// with a single depth-first search, using Tarjan's low-link values: the low-link of a node is the earliest
+// discovered node its subtree can reach through one edge that is not part of the search tree.
+// The graph (g) is represented as an adjacency list built by graph.GenerateGraphFromEdges with graph.Bidirectional.
+// Parallel edges are never bridges, and self loops are ignored.
+func Analyze[T cmp.Ordered](g map[T][]T) *Analysis[T] {
+	index := make(map[T]int, len(g))
+	lowLink := make(map[T]int, len(g))
+	points := make(map[T]bool)
+	// edges holds the edges of the biconnected components still being explored.
+	edges := make([][2]T, 0)
+
+	analysis := &Analysis[T]{
+		Bridges:               make([][]T, 0),
+		ArticulationPoints:    make([]T, 0),
+		BiconnectedComponents: make([][]T, 0),
+	}
+
+	var visit func(node T, parent *T)
+	visit = func(node T, parent *T) {
+		index[node] = len(index)
+		lowLink[node] = index[node]
+		children := 0
+
+		// Only one of the edges back to the parent is the tree edge; any other is a parallel edge.
+		skippedParent := false
+		for _, neighbor := range g[node] {
+			if neighbor == node {
+				continue
+			}
+			if parent != nil && neighbor == *parent && !skippedParent {
+				skippedParent = true
+				continue
+			}
+
+			if _, visited := index[neighbor]; visited {
+				if index[neighbor] < index[node] {
+					edges = append(edges, [2]T{node, neighbor})
+					lowLink[node] = min(lowLink[node], index[neighbor])
+				}
+				continue
+			}
+
+			children++
+			edges = append(edges, [2]T{node, neighbor})
+			visit(neighbor, &node)
+			lowLink[node] = min(lowLink[node], lowLink[neighbor])
+
+			// The neighbor's subtree can't reach node or above without this edge
+			if lowLink[neighbor] > index[node] {
+				analysis.Bridges = append(analysis.Bridges, []T{min(node, neighbor), max(node, neighbor)})
+			}
+			// The neighbor's subtree can't reach above node, so node separates it from the rest
+			if lowLink[neighbor] >= index[node] {
+				if parent != nil {
+					points[node] = true
+				}
+				analysis.BiconnectedComponents = append(analysis.BiconnectedComponents, popComponent(&edges, node, neighbor))
+			}
+		}
+
+		// The root separates its subtrees only if it has more than one
+		if parent == nil && children > 1 {
+			points[node] = true
+		}
+	}
+
+	nodes := make([]T, 0, len(g))
+	for node := range g {
+		nodes = append(nodes, node)
+	}
+	slices.Sort(nodes)
+	for _, node := range nodes {
+		if _, visited := index[node]; !visited {
+			visit(node, nil)
+		}
+	}
+
+	for point := range points {
+		analysis.ArticulationPoints = append(analysis.ArticulationPoints, point)
+	}
+	slices.Sort(analysis.ArticulationPoints)
+	slices.SortFunc(analysis.Bridges, slices.Compare)
+	slices.SortFunc(analysis.BiconnectedComponents, slices.Compare)
+
+	return analysis
+}
+
+// Bridges returns the edges of an undirected graph whose removal disconnects it.
+// See Analyze for details.
+func Bridges[T cmp.Ordered](g map[T][]T) [][]T {
+	return Analyze(g).Bridges
+}
+
+// ArticulationPoints returns the nodes of an undirected graph whose removal disconnects it.
+// See Analyze for details.
+func ArticulationPoints[T cmp.Ordered](g map[T][]T) []T {
+	return Analyze(g).ArticulationPoints
+}
+
+// BiconnectedComponents returns the nodes of every biconnected component of an undirected graph.
+// See Analyze for details.
+func BiconnectedComponents[T cmp.Ordered](g map[T][]T) [][]T {
+	return Analyze(g).BiconnectedComponents
+}
+
+// popComponent pops the edges of a finished biconnected component off the stack, down to the tree edge
+// from node to child, and returns the component's nodes in ascending order.
+func popComponent[T cmp.Ordered](edges *[][2]T, node, child T) []T {
+	members := make(map[T]bool)
+	for len(*edges) > 0 {
+		edge := (*edges)[len(*edges)-1]
+		*edges = (*edges)[:len(*edges)-1]
+		members[edge[0]] = true
+		members[edge[1]] = true
+
+		if edge == [2]T{node, child} {
+			break
+		}
+	}
+
+	component := make([]T, 0, len(members))
+	for member := range members {
+		component = append(component, member)
+	}
+	slices.Sort(component)
+	return component
+}
@@ -0,0 +1,179 @@
+package bridges
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/sosalejandro/algo-practice/graph"
+)
+
+func TestAnalyze(t *testing.T) {
+	tests := []struct {
+		name       string
+		edges      [][]int
+		bridges    [][]int
+		points     []int
+		components [][]int
+	}{
+		{
+			name:       "Empty Graph",
+			edges:      [][]int{},
+			bridges:    [][]int{},
+			points:     []int{},
+			components: [][]int{},
+		},
+		{
+			name:       "Single Edge",
+			edges:      [][]int{{1, 2}},
+			bridges:    [][]int{{1, 2}},
+			points:     []int{},
+			components: [][]int{{1, 2}},
+		},
+		{
+			name:       "Path",
+			edges:      [][]int{{3, 2}, {2, 1}},
+			bridges:    [][]int{{1, 2}, {2, 3}},
+			points:     []int{2},
+			components: [][]int{{1, 2}, {2, 3}},
+		},
+		{
+			name:       "Cycle",
+			edges:      [][]int{{1, 2}, {2, 3}, {3, 4}, {4, 1}},
+			bridges:    [][]int{},
+			points:     []int{},
+			components: [][]int{{1, 2, 3, 4}},
+		},
+		{
+			name: "Two Cycles Joined By A Bridge",
+			edges: [][]int{
+				{1, 2}, {2, 3}, {3, 1},
+				{3, 4},
+				{4, 5}, {5, 6}, {6, 4},
+			},
+			bridges:    [][]int{{3, 4}},
+			points:     []int{3, 4},
+			components: [][]int{{1, 2, 3}, {3, 4}, {4, 5, 6}},
+		},
+		{
+			name: "Bowtie Shares A Node",
+			edges: [][]int{
+				{1, 2}, {2, 3}, {3, 1},
+				{3, 4}, {4, 5}, {5, 3},
+			},
+			bridges:    [][]int{},
+			points:     []int{3},
+			components: [][]int{{1, 2, 3}, {3, 4, 5}},
+		},
+		{
+			name:       "Parallel Edges Are Not Bridges",
+			edges:      [][]int{{1, 2}, {2, 1}, {2, 3}},
+			bridges:    [][]int{{2, 3}},
+			points:     []int{2},
+			components: [][]int{{1, 2}, {2, 3}},
+		},
+		{
+			name:       "Star Root Is An Articulation Point",
+			edges:      [][]int{{1, 2}, {1, 3}, {1, 4}, {5, 5}},
+			bridges:    [][]int{{1, 2}, {1, 3}, {1, 4}},
+			points:     []int{1},
+			components: [][]int{{1, 2}, {1, 3}, {1, 4}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := graph.GenerateGraphFromEdges(tt.edges, graph.Bidirectional)
+
+			analysis := Analyze(g)
+			if !reflect.DeepEqual(analysis.Bridges, tt.bridges) {
+				t.Errorf("expected bridges %v, got %v", tt.bridges, analysis.Bridges)
+			}
+			if !reflect.DeepEqual(analysis.ArticulationPoints, tt.points) {
+				t.Errorf("expected articulation points %v, got %v", tt.points, analysis.ArticulationPoints)
+			}
+			if !reflect.DeepEqual(analysis.BiconnectedComponents, tt.components) {
+				t.Errorf("expected biconnected components %v, got %v", tt.components, analysis.BiconnectedComponents)
+			}
+
+			if got := Bridges(g); !reflect.DeepEqual(got, tt.bridges) {
+				t.Errorf("expected Bridges to return %v, got %v", tt.bridges, got)
+			}
+			if got := ArticulationPoints(g); !reflect.DeepEqual(got, tt.points) {
+				t.Errorf("expected ArticulationPoints to return %v, got %v", tt.points, got)
+			}
+			if got := BiconnectedComponents(g); !reflect.DeepEqual(got, tt.components) {
+				t.Errorf("expected BiconnectedComponents to return %v, got %v", tt.components, got)
+			}
+		})
+	}
+}
+
+func TestBridges_MatchBruteForce(t *testing.T) {
+	edges := [][]int{
+		{1, 2}, {2, 3}, {3, 1}, {3, 4}, {4, 5}, {5, 6}, {6, 7}, {7, 5},
+		{7, 8}, {8, 9}, {9, 10}, {10, 8}, {2, 11}, {11, 12},
+	}
+	g := graph.GenerateGraphFromEdges(edges, graph.Bidirectional)
+	components := countComponents(g, nil, nil)
+
+	expected := make([][]int, 0)
+	for _, edge := range edges {
+		if countComponents(g, edge, nil) > components {
+			expected = append(expected, []int{min(edge[0], edge[1]), max(edge[0], edge[1])})
+		}
+	}
+	if got := Bridges(g); !sameEdges(got, expected) {
+		t.Errorf("expected bridges %v, got %v", expected, got)
+	}
+
+	for _, point := range ArticulationPoints(g) {
+		if countComponents(g, nil, &point) <= components {
+			t.Errorf("expected removing %v to disconnect the graph", point)
+		}
+	}
+}
+
+// countComponents counts the connected components of g without the given edge or node, either of which may be nil.
+func countComponents(g map[int][]int, skipEdge []int, skipNode *int) int {
+	skipped := func(a, b int) bool {
+		return skipEdge != nil && ((a == skipEdge[0] && b == skipEdge[1]) || (a == skipEdge[1] && b == skipEdge[0]))
+	}
+
+	visited := make(map[int]bool)
+	var visit func(node int)
+	visit = func(node int) {
+		visited[node] = true
+		for _, neighbor := range g[node] {
+			if !visited[neighbor] && !skipped(node, neighbor) && (skipNode == nil || neighbor != *skipNode) {
+				visit(neighbor)
+			}
+		}
+	}
+
+	count := 0
+	for node := range g {
+		if !visited[node] && (skipNode == nil || node != *skipNode) {
+			count++
+			visit(node)
+		}
+	}
+	return count
+}
+
+func sameEdges(got, expected [][]int) bool {
+	if len(got) != len(expected) {
+		return false
+	}
+	for _, edge := range expected {
+		found := false
+		for _, g := range got {
+			if reflect.DeepEqual(g, edge) {
+				found = true
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
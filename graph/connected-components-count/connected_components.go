@@ -0,0 +1,96 @@
+package connected_components_count
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/sosalejandro/algo-practice/data-structures/common"
+	"github.com/sosalejandro/algo-practice/graph"
+)
+
+// Components describes the connected components of a graph.
+type Components[T cmp.Ordered] struct {
+	// Members holds the nodes of every component in ascending order.
+	// Components are ordered by their smallest node, so the result does not depend on map iteration order.
+	Members [][]T
+	// ComponentOf maps every node to the index of its component in Members.
+	ComponentOf map[T]int
+	// Largest is the number of nodes in the largest component, or zero for an empty graph.
+	Largest int
+}
+
+// ConnectedComponents returns the connected components of a graph.
+// Like ConnectedComponentsCount, it expects an undirected graph such as the one built by
+// graph.GenerateGraphFromEdges with graph.Bidirectional.
+// The itemFactory parameter is used to create items for the transporter.
+// The function returns an error if the transporter encounters an error, such as
+// common.ErrInvalidItem when itemFactory produces an empty item for a node.
+func ConnectedComponents[T cmp.Ordered](strategy graph.TraversalStrategy, g map[T][]T, itemFactory common.ItemFactory[T]) (*Components[T], error) {
+	transporter := graph.NewTransporter[T](strategy, itemFactory)
+	if transporter == nil {
+		return &Components[T]{Members: [][]T{}, ComponentOf: map[T]int{}}, nil
+	}
+
+	return ConnectedComponentsWithTransporter(transporter, g)
+}
+
+// ConnectedComponentsWithTransporter is like ConnectedComponents but explores the graph
+// with the given transporter. The transporter should be empty.
+func ConnectedComponentsWithTransporter[T cmp.Ordered](transporter graph.Transporter[T], g map[T][]T) (*Components[T], error) {
+	components := &Components[T]{
+		Members:     make([][]T, 0),
+		ComponentOf: make(map[T]int),
+	}
+
+	// Start from the nodes in ascending order so every run explores the graph the same way
+	nodes := make([]T, 0, len(g))
+	for node := range g {
+		nodes = append(nodes, node)
+	}
+	slices.Sort(nodes)
+
+	visited := make(map[T]bool)
+	for _, node := range nodes {
+		if visited[node] {
+			continue
+		}
+
+		if err := transporter.Add(node); err != nil {
+			return nil, err
+		}
+		visited[node] = true
+		members := []T{node}
+
+		for !transporter.IsEmpty() {
+			currentItem, err := transporter.Next()
+			if err != nil {
+				return nil, err
+			}
+			current := currentItem.Value()
+
+			for _, neighbor := range g[current] {
+				if !visited[neighbor] {
+					visited[neighbor] = true
+					members = append(members, neighbor)
+					if err := transporter.Add(neighbor); err != nil {
+						return nil, err
+					}
+				}
+			}
+		}
+
+		slices.Sort(members)
+		components.Members = append(components.Members, members)
+		components.Largest = max(components.Largest, len(members))
+	}
+
+	// A neighbor missing from the adjacency list can be smaller than the node its component was found from
+	slices.SortFunc(components.Members, func(a, b []T) int { return cmp.Compare(a[0], b[0]) })
+	for id, members := range components.Members {
+		for _, member := range members {
+			components.ComponentOf[member] = id
+		}
+	}
+
+	return components, nil
+}
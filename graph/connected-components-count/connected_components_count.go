@@ -38,7 +38,8 @@ var IntItemFactory common.ItemFactory[int] = func(value int) common.Item[int] {
 // ConnectedComponentsCount returns the number of connected components in a graph.
 // The graph (g) is represented as an adjacency list.
 // The itemFactory parameter is used to create items for the transporter.
-// The function returns an error if the transporter encounters an error.
+// The function returns an error if the transporter encounters an error, such as
+// common.ErrInvalidItem when itemFactory produces an empty item for a node.
 func ConnectedComponentsCount[T comparable](strategy graph.TraversalStrategy, g map[T][]T, itemFactory common.ItemFactory[T]) (count int, err error) {
 	if len(g) == 0 {
 		return 0, nil
@@ -49,12 +50,21 @@ func ConnectedComponentsCount[T comparable](strategy graph.TraversalStrategy, g
 		return 0, nil
 	}
 
+	return ConnectedComponentsCountWithTransporter(transporter, g)
+}
+
+// ConnectedComponentsCountWithTransporter is like ConnectedComponentsCount but explores the graph
+// with the given transporter, such as a graph.PriorityTransporter for a best-first traversal.
+// The transporter should be empty.
+func ConnectedComponentsCountWithTransporter[T comparable](transporter graph.Transporter[T], g map[T][]T) (count int, err error) {
 	visited := make(map[T]bool)
 
 	for node := range g {
 		if !visited[node] {
 			count++
-			transporter.Add(node)
+			if err := transporter.Add(node); err != nil {
+				return count, err
+			}
 			visited[node] = true
 
 			for !transporter.IsEmpty() {
@@ -67,7 +77,9 @@ func ConnectedComponentsCount[T comparable](strategy graph.TraversalStrategy, g
 				for _, neighbor := range g[current] {
 					if !visited[neighbor] {
 						visited[neighbor] = true
-						transporter.Add(neighbor)
+						if err := transporter.Add(neighbor); err != nil {
+							return count, err
+						}
 					}
 				}
 			}
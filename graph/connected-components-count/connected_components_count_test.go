@@ -1,8 +1,10 @@
 package connected_components_count
 
 import (
+	"errors"
 	"testing"
 
+	"github.com/sosalejandro/algo-practice/data-structures/common"
 	"github.com/sosalejandro/algo-practice/graph"
 )
 
@@ -193,5 +195,30 @@ func TestConnectedComponentsCountBFS(t *testing.T) {
 				t.Errorf("expected %v, got %v", tt.expected, result)
 			}
 		})
+
+		t.Run(tt.name+"_PriorityTraversal", func(t *testing.T) {
+			transporter := graph.NewPriorityTransporter(func(node int) int { return -node }, IntItemFactory)
+			result, err := ConnectedComponentsCountWithTransporter(transporter, tt.graph)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if result != tt.expected {
+				t.Errorf("expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestConnectedComponentsCount_InvalidItem(t *testing.T) {
+	g := map[int][]int{
+		0: {1},
+		1: {0},
+	}
+
+	for _, strategy := range []graph.TraversalStrategy{graph.StackTraversal, graph.QueueTraversal} {
+		_, err := ConnectedComponentsCount(strategy, g, IntItemFactory)
+		if !errors.Is(err, common.ErrInvalidItem) {
+			t.Errorf("expected error %v, got %v", common.ErrInvalidItem, err)
+		}
 	}
 }
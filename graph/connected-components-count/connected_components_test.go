@@ -0,0 +1,98 @@
+package connected_components_count
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/sosalejandro/algo-practice/data-structures/common"
+	"github.com/sosalejandro/algo-practice/graph"
+)
+
+func TestConnectedComponents(t *testing.T) {
+	tests := []struct {
+		name        string
+		graph       map[int][]int
+		members     [][]int
+		componentOf map[int]int
+		largest     int
+	}{
+		{
+			name:        "Empty Graph",
+			graph:       map[int][]int{},
+			members:     [][]int{},
+			componentOf: map[int]int{},
+			largest:     0,
+		},
+		{
+			name: "Single Node Graph",
+			graph: map[int][]int{
+				1: {},
+			},
+			members:     [][]int{{1}},
+			componentOf: map[int]int{1: 0},
+			largest:     1,
+		},
+		{
+			name: "Graph with Multiple Components",
+			graph: graph.GenerateGraphFromEdges([][]int{
+				{8, 5}, {5, 1}, {1, 3}, {4, 2}, {6, 7}, {7, 9}, {9, 6},
+			}, graph.Bidirectional),
+			members:     [][]int{{1, 3, 5, 8}, {2, 4}, {6, 7, 9}},
+			componentOf: map[int]int{1: 0, 3: 0, 5: 0, 8: 0, 2: 1, 4: 1, 6: 2, 7: 2, 9: 2},
+			largest:     4,
+		},
+		{
+			name: "Neighbor Missing From Adjacency List",
+			graph: map[int][]int{
+				5: {1},
+				3: {},
+			},
+			members:     [][]int{{1, 5}, {3}},
+			componentOf: map[int]int{1: 0, 5: 0, 3: 1},
+			largest:     2,
+		},
+	}
+
+	for _, strategy := range []graph.TraversalStrategy{graph.StackTraversal, graph.QueueTraversal} {
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				result, err := ConnectedComponents(strategy, tt.graph, IntItemFactory)
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if !reflect.DeepEqual(result.Members, tt.members) {
+					t.Errorf("expected members %v, got %v", tt.members, result.Members)
+				}
+				if !reflect.DeepEqual(result.ComponentOf, tt.componentOf) {
+					t.Errorf("expected component ids %v, got %v", tt.componentOf, result.ComponentOf)
+				}
+				if result.Largest != tt.largest {
+					t.Errorf("expected largest component of size %v, got %v", tt.largest, result.Largest)
+				}
+
+				count, err := ConnectedComponentsCount(strategy, tt.graph, IntItemFactory)
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if count != len(result.Members) {
+					t.Errorf("expected %v components as counted by ConnectedComponentsCount, got %v", count, len(result.Members))
+				}
+			})
+		}
+	}
+}
+
+func TestConnectedComponents_InvalidItem(t *testing.T) {
+	g := map[int][]int{
+		0: {1},
+		1: {0},
+	}
+
+	for _, strategy := range []graph.TraversalStrategy{graph.StackTraversal, graph.QueueTraversal} {
+		_, err := ConnectedComponents(strategy, g, IntItemFactory)
+		if !errors.Is(err, common.ErrInvalidItem) {
+			t.Errorf("expected error %v, got %v", common.ErrInvalidItem, err)
+		}
+	}
+}
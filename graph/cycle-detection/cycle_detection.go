@@ -0,0 +1,88 @@
+package cycle_detection
+
+import (
+	"cmp"
+	"errors"
+	"slices"
+
+	"github.com/sosalejandro/algo-practice/graph"
+	topological_sort "github.com/sosalejandro/algo-practice/graph/topological-sort"
+)
+
+// HasCycle checks if a graph built by graph.GenerateGraphFromEdges has a cycle and returns one if it does.
+// The cycle lists its nodes in edge order, starting from its smallest node, without repeating that node at the end.
+// Nodes are explored in ascending order so the cycle returned is the same on every run.
+//
+// Directional graphs are searched with a three-color depth-first search, where an edge back to a node
+// still on the current path closes a cycle.
+// Bidirectional graphs store every edge in both directions, so walking back over the edge just used
+// is not a cycle; the search skips that edge once. Two parallel edges between the same nodes,
+// and self loops, are cycles.
+func HasCycle[T cmp.Ordered](g map[T][]T, graphType graph.GraphType) ([]T, bool) {
+	if graphType == graph.Bidirectional {
+		cycle := undirectedCycle(g)
+		return cycle, cycle != nil
+	}
+
+	// A directed graph has a cycle exactly when it has no topological order
+	var cycleErr *topological_sort.CycleError[T]
+	if _, err := topological_sort.DFS(g); errors.As(err, &cycleErr) {
+		return cycleErr.Cycle, true
+	}
+	return nil, false
+}
+
+// undirectedCycle runs a depth-first search that ignores the edge leading back to each node's parent
+// and returns the first cycle found, or nil if the graph is a forest.
+func undirectedCycle[T cmp.Ordered](g map[T][]T) []T {
+	nodes := make([]T, 0, len(g))
+	for node := range g {
+		nodes = append(nodes, node)
+	}
+	slices.Sort(nodes)
+
+	visited := make(map[T]bool, len(g))
+	path := make([]T, 0)
+
+	var visit func(node T, parent *T) []T
+	visit = func(node T, parent *T) []T {
+		visited[node] = true
+		path = append(path, node)
+
+		skippedParent := false
+		for _, neighbor := range g[node] {
+			if parent != nil && neighbor == *parent && !skippedParent {
+				skippedParent = true
+				continue
+			}
+
+			// In an undirected search every edge to a visited node leads back to an ancestor,
+			// since edges to descendants were already followed from the other end.
+			// Only an adjacency list missing some reverse edges can lead elsewhere; such edges are ignored.
+			if visited[neighbor] {
+				ancestor := slices.Index(path, neighbor)
+				if ancestor < 0 {
+					continue
+				}
+				cycle := path[ancestor:]
+				start := slices.Index(cycle, slices.Min(cycle))
+				return slices.Concat(cycle[start:], cycle[:start])
+			}
+			if cycle := visit(neighbor, &node); cycle != nil {
+				return cycle
+			}
+		}
+
+		path = path[:len(path)-1]
+		return nil
+	}
+
+	for _, node := range nodes {
+		if !visited[node] {
+			if cycle := visit(node, nil); cycle != nil {
+				return cycle
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,129 @@
+package cycle_detection
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/sosalejandro/algo-practice/graph"
+)
+
+func TestHasCycle(t *testing.T) {
+	tests := []struct {
+		name      string
+		edges     [][]string
+		graphType graph.GraphType
+		expected  []string
+	}{
+		{
+			name:      "Directional: Empty Graph",
+			edges:     [][]string{},
+			graphType: graph.Directional,
+			expected:  nil,
+		},
+		{
+			name:      "Directional: Diamond Is Acyclic",
+			edges:     [][]string{{"A", "B"}, {"A", "C"}, {"B", "D"}, {"C", "D"}},
+			graphType: graph.Directional,
+			expected:  nil,
+		},
+		{
+			name:      "Directional: Cycle",
+			edges:     [][]string{{"A", "B"}, {"B", "C"}, {"C", "D"}, {"D", "B"}},
+			graphType: graph.Directional,
+			expected:  []string{"B", "C", "D"},
+		},
+		{
+			name:      "Directional: Opposite Edges Form A Cycle",
+			edges:     [][]string{{"A", "B"}, {"B", "A"}},
+			graphType: graph.Directional,
+			expected:  []string{"A", "B"},
+		},
+		{
+			name:      "Bidirectional: Single Edge Is Not A Cycle",
+			edges:     [][]string{{"A", "B"}},
+			graphType: graph.Bidirectional,
+			expected:  nil,
+		},
+		{
+			name:      "Bidirectional: Tree",
+			edges:     [][]string{{"A", "B"}, {"A", "C"}, {"B", "D"}, {"B", "E"}, {"F", "G"}},
+			graphType: graph.Bidirectional,
+			expected:  nil,
+		},
+		{
+			name:      "Bidirectional: Diamond Is A Cycle",
+			edges:     [][]string{{"A", "B"}, {"A", "C"}, {"B", "D"}, {"C", "D"}},
+			graphType: graph.Bidirectional,
+			expected:  []string{"A", "B", "D", "C"},
+		},
+		{
+			name:      "Bidirectional: Cycle In Second Component",
+			edges:     [][]string{{"A", "B"}, {"X", "Y"}, {"Y", "Z"}, {"Z", "X"}},
+			graphType: graph.Bidirectional,
+			expected:  []string{"X", "Y", "Z"},
+		},
+		{
+			name:      "Bidirectional: Parallel Edges",
+			edges:     [][]string{{"A", "B"}, {"B", "A"}},
+			graphType: graph.Bidirectional,
+			expected:  []string{"A", "B"},
+		},
+		{
+			name:      "Bidirectional: Self Loop",
+			edges:     [][]string{{"A", "B"}, {"B", "B"}},
+			graphType: graph.Bidirectional,
+			expected:  []string{"B"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := graph.GenerateGraphFromEdges(tt.edges, tt.graphType)
+
+			cycle, found := HasCycle(g, tt.graphType)
+			if found != (tt.expected != nil) {
+				t.Fatalf("expected found %v, got %v", tt.expected != nil, found)
+			}
+			if !reflect.DeepEqual(cycle, tt.expected) {
+				t.Errorf("expected cycle %v, got %v", tt.expected, cycle)
+			}
+			if found && !isCycle(g, cycle) {
+				t.Errorf("expected %v to follow edges of the graph", cycle)
+			}
+		})
+	}
+}
+
+func TestHasCycle_MissingReverseEdges(t *testing.T) {
+	// Not a proper Bidirectional adjacency list: C points to B without B pointing back,
+	// so the edge from C leads to B, which is already finished rather than an ancestor, and is ignored.
+	g := map[string][]string{
+		"A": {"B", "C"},
+		"B": {"A"},
+		"C": {"A", "B"},
+	}
+
+	if cycle, found := HasCycle(g, graph.Bidirectional); found {
+		t.Errorf("expected no cycle, got %v", cycle)
+	}
+}
+
+// isCycle checks that every consecutive pair of nodes in cycle, wrapping around, is joined by an edge.
+func isCycle(g map[string][]string, cycle []string) bool {
+	for i, node := range cycle {
+		next := cycle[(i+1)%len(cycle)]
+		if !contains(g[node], next) {
+			return false
+		}
+	}
+	return true
+}
+
+func contains(nodes []string, node string) bool {
+	for _, n := range nodes {
+		if n == node {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,90 @@
+package dijkstra
+
+import (
+	"fmt"
+
+	priority_queue "github.com/sosalejandro/algo-practice/data-structures/priority-queue"
+	"github.com/sosalejandro/algo-practice/graph"
+)
+
+// NegativeWeightError is returned when the graph contains an edge with a negative weight,
+// which Dijkstra's algorithm cannot handle. Use the bellman_ford package for such graphs.
+type NegativeWeightError[T comparable, W graph.Weight] struct {
+	Edge graph.WeightedEdge[T, W]
+}
+
+func (e *NegativeWeightError[T, W]) Error() string {
+	return fmt.Sprintf("edge %v -> %v has negative weight %v", e.Edge.Src, e.Edge.Dst, e.Edge.Weight)
+}
+
+// Dijkstra finds the cheapest path from src to every node reachable from it.
+// The returned tree holds the distance and predecessor of every reached node;
+// use its PathTo method to reconstruct a path.
+// If src is not in the graph the tree is empty.
+// It returns a *NegativeWeightError if any edge of the graph has a negative weight.
+func Dijkstra[T comparable, W graph.Weight](g *graph.WeightedGraph[T, W], src T) (*graph.ShortestPathTree[T, W], error) {
+	return search(g, src, func(T) bool { return false })
+}
+
+// DijkstraTo is like Dijkstra but stops as soon as the cheapest path to dst is known.
+// The returned tree only holds the nodes settled before dst, so distances to nodes
+// farther away than dst are missing rather than wrong.
+func DijkstraTo[T comparable, W graph.Weight](g *graph.WeightedGraph[T, W], src, dst T) (*graph.ShortestPathTree[T, W], error) {
+	return search(g, src, func(node T) bool { return node == dst })
+}
+
+// search runs Dijkstra's algorithm from src until the queue is exhausted or done reports true for a settled node.
+func search[T comparable, W graph.Weight](g *graph.WeightedGraph[T, W], src T, done func(T) bool) (*graph.ShortestPathTree[T, W], error) {
+	for _, edge := range g.Edges() {
+		if edge.Weight < 0 {
+			return nil, &NegativeWeightError[T, W]{Edge: edge}
+		}
+	}
+
+	tree := graph.NewShortestPathTree[T, W](src)
+	if !g.HasNode(src) {
+		return tree, nil
+	}
+
+	// Every discovered node keeps its handle so a cheaper path can lower its priority in place.
+	// A handle that is no longer in the queue belongs to a settled node.
+	queue := priority_queue.NewPriorityQueue[T, W]()
+	handles := map[T]*priority_queue.Handle[T, W]{src: queue.Push(src, 0)}
+	predecessors := make(map[T]T)
+
+	for !queue.IsEmpty() {
+		current, err := queue.Pop()
+		if err != nil {
+			return nil, err
+		}
+
+		node := current.Value()
+		tree.Distances[node] = current.Priority()
+		if predecessor, exists := predecessors[node]; exists {
+			tree.Predecessors[node] = predecessor
+		}
+		if done(node) {
+			break
+		}
+
+		for _, neighbor := range g.Neighbors(node) {
+			distance := current.Priority() + neighbor.Weight
+
+			handle, discovered := handles[neighbor.Node]
+			if !discovered {
+				handles[neighbor.Node] = queue.Push(neighbor.Node, distance)
+				predecessors[neighbor.Node] = node
+				continue
+			}
+			if !queue.Contains(handle) || distance >= handle.Priority() {
+				continue
+			}
+			if err := queue.DecreaseKey(handle, distance); err != nil {
+				return nil, err
+			}
+			predecessors[neighbor.Node] = node
+		}
+	}
+
+	return tree, nil
+}
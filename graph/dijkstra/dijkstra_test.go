@@ -0,0 +1,132 @@
+package dijkstra
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/sosalejandro/algo-practice/graph"
+)
+
+func generateRoutes() *graph.WeightedGraph[string, int] {
+	return graph.GenerateWeightedGraphFromEdges([]graph.WeightedEdge[string, int]{
+		{Src: "A", Dst: "B", Weight: 4},
+		{Src: "A", Dst: "C", Weight: 1},
+		{Src: "C", Dst: "B", Weight: 2},
+		{Src: "B", Dst: "D", Weight: 1},
+		{Src: "C", Dst: "D", Weight: 5},
+		{Src: "D", Dst: "E", Weight: 3},
+		{Src: "F", Dst: "A", Weight: 1},
+	}, graph.Directional)
+}
+
+func TestDijkstra(t *testing.T) {
+	tests := []struct {
+		name      string
+		dst       string
+		distance  int
+		path      []string
+		reachable bool
+	}{
+		{name: "Source", dst: "A", distance: 0, path: []string{"A"}, reachable: true},
+		{name: "Cheaper Detour", dst: "B", distance: 3, path: []string{"A", "C", "B"}, reachable: true},
+		{name: "Detour Beats Direct Edge", dst: "D", distance: 4, path: []string{"A", "C", "B", "D"}, reachable: true},
+		{name: "Farthest Node", dst: "E", distance: 7, path: []string{"A", "C", "B", "D", "E"}, reachable: true},
+		{name: "Unreachable Node", dst: "F", reachable: false},
+		{name: "Unknown Node", dst: "Z", reachable: false},
+	}
+
+	tree, err := Dijkstra(generateRoutes(), "A")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			distance, reachable := tree.DistanceTo(tt.dst)
+			if reachable != tt.reachable {
+				t.Fatalf("expected reachable %v, got %v", tt.reachable, reachable)
+			}
+			if distance != tt.distance {
+				t.Errorf("expected distance %v, got %v", tt.distance, distance)
+			}
+
+			path, found := tree.PathTo(tt.dst)
+			if found != tt.reachable {
+				t.Errorf("expected path found %v, got %v", tt.reachable, found)
+			}
+			if !reflect.DeepEqual(path, tt.path) {
+				t.Errorf("expected path %v, got %v", tt.path, path)
+			}
+		})
+	}
+}
+
+func TestDijkstra_Bidirectional(t *testing.T) {
+	g := graph.GenerateWeightedGraphFromEdges([]graph.WeightedEdge[string, float64]{
+		{Src: "A", Dst: "B", Weight: 2.5},
+		{Src: "B", Dst: "C", Weight: 0.5},
+		{Src: "A", Dst: "C", Weight: 4},
+	}, graph.Bidirectional)
+
+	tree, err := Dijkstra(g, "C")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := map[string]float64{"A": 3, "B": 0.5, "C": 0}
+	if !reflect.DeepEqual(tree.Distances, expected) {
+		t.Errorf("expected distances %v, got %v", expected, tree.Distances)
+	}
+	if path, _ := tree.PathTo("A"); !reflect.DeepEqual(path, []string{"C", "B", "A"}) {
+		t.Errorf("expected path [C B A], got %v", path)
+	}
+}
+
+func TestDijkstra_UnknownSource(t *testing.T) {
+	tree, err := Dijkstra(generateRoutes(), "Z")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(tree.Distances) != 0 {
+		t.Errorf("expected no distances, got %v", tree.Distances)
+	}
+	if _, found := tree.PathTo("Z"); found {
+		t.Errorf("expected no path to an unknown source")
+	}
+}
+
+func TestDijkstra_NegativeWeight(t *testing.T) {
+	g := graph.GenerateWeightedGraphFromEdges([]graph.WeightedEdge[string, int]{
+		{Src: "A", Dst: "B", Weight: 1},
+		{Src: "B", Dst: "C", Weight: -2},
+	}, graph.Directional)
+
+	_, err := Dijkstra(g, "A")
+
+	var negativeWeightErr *NegativeWeightError[string, int]
+	if !errors.As(err, &negativeWeightErr) {
+		t.Fatalf("expected a NegativeWeightError, got %v", err)
+	}
+	expected := graph.WeightedEdge[string, int]{Src: "B", Dst: "C", Weight: -2}
+	if negativeWeightErr.Edge != expected {
+		t.Errorf("expected offending edge %v, got %v", expected, negativeWeightErr.Edge)
+	}
+}
+
+func TestDijkstraTo(t *testing.T) {
+	tree, err := DijkstraTo(generateRoutes(), "A", "B")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if path, found := tree.PathTo("B"); !found || !reflect.DeepEqual(path, []string{"A", "C", "B"}) {
+		t.Errorf("expected path [A C B], got %v", path)
+	}
+	// D and E are farther than B, so the search stops before settling them
+	for _, node := range []string{"D", "E"} {
+		if _, reached := tree.DistanceTo(node); reached {
+			t.Errorf("expected %v not to be settled", node)
+		}
+	}
+}
@@ -1,7 +1,12 @@
 package graph
 
 import (
+	"cmp"
+	"context"
+
 	"github.com/sosalejandro/algo-practice/data-structures/common"
+	"github.com/sosalejandro/algo-practice/data-structures/deque"
+	priority_queue "github.com/sosalejandro/algo-practice/data-structures/priority-queue"
 	simple_queue "github.com/sosalejandro/algo-practice/data-structures/simple-queue"
 	simple_stack "github.com/sosalejandro/algo-practice/data-structures/simple-stack"
 )
@@ -16,10 +21,14 @@ const (
 
 // Transporter is a generic interface for traversal methods, supporting Stack and Queue
 // so that HasPath can use either data structure.
+// Implementations report failures with the sentinel errors of the common package,
+// so callers can tell them apart with errors.Is.
 type Transporter[T any] interface {
 	// Next returns the next item in the transporter.
+	// It returns common.ErrEmpty if there are no items left.
 	Next() (common.Item[T], error)
 	// Add adds an item to the transporter.
+	// It returns common.ErrInvalidItem if the item created for element is nil or empty.
 	Add(element T) error
 	// IsEmpty checks if the transporter is empty.
 	IsEmpty() bool
@@ -71,6 +80,80 @@ func (q *QueueTransporter[T]) IsEmpty() bool {
 	return q.queue.IsEmpty()
 }
 
+// DequeTransporter adapts a deque to implement the generic Transporter interface.
+// Next always takes the item at the front; Add pushes to the back under QueueTraversal
+// and to the front under StackTraversal, so the same transporter serves both strategies.
+// AddFront and AddBack bypass the strategy, which 0-1 BFS uses to visit zero-weight edges first.
+type DequeTransporter[T any] struct {
+	deque       *deque.Deque[T, common.Item[T]]
+	itemFactory common.ItemFactory[T]
+	strategy    TraversalStrategy
+}
+
+// NewDequeTransporter creates a new generic DequeTransporter that behaves as a stack or a queue depending on strategy.
+func NewDequeTransporter[T any](strategy TraversalStrategy, itemFactory common.ItemFactory[T]) *DequeTransporter[T] {
+	return &DequeTransporter[T]{deque.NewDeque[T, common.Item[T]](), itemFactory, strategy}
+}
+
+func (d *DequeTransporter[T]) Next() (common.Item[T], error) {
+	return d.deque.PopFront()
+}
+
+func (d *DequeTransporter[T]) Add(element T) error {
+	if d.strategy == StackTraversal {
+		return d.AddFront(element)
+	}
+	return d.AddBack(element)
+}
+
+// AddFront adds an item so that it is returned by the next call to Next.
+func (d *DequeTransporter[T]) AddFront(element T) error {
+	return d.deque.PushFront(d.itemFactory(element))
+}
+
+// AddBack adds an item so that it is returned after every item already in the transporter.
+func (d *DequeTransporter[T]) AddBack(element T) error {
+	return d.deque.PushBack(d.itemFactory(element))
+}
+
+func (d *DequeTransporter[T]) IsEmpty() bool {
+	return d.deque.IsEmpty()
+}
+
+// PriorityTransporter adapts a priority queue to implement the generic Transporter interface.
+// Next returns the item with the lowest priority first, which turns a traversal into a best-first search.
+type PriorityTransporter[T any, P cmp.Ordered] struct {
+	queue       *priority_queue.PriorityQueue[common.Item[T], P]
+	itemFactory common.ItemFactory[T]
+	priority    func(element T) P
+}
+
+// NewPriorityTransporter creates a new generic PriorityTransporter that orders items by priority.
+func NewPriorityTransporter[T any, P cmp.Ordered](priority func(element T) P, itemFactory common.ItemFactory[T]) *PriorityTransporter[T, P] {
+	return &PriorityTransporter[T, P]{priority_queue.NewPriorityQueue[common.Item[T], P](), itemFactory, priority}
+}
+
+func (p *PriorityTransporter[T, P]) Next() (common.Item[T], error) {
+	h, err := p.queue.Pop()
+	if err != nil {
+		return nil, err
+	}
+	return h.Value(), nil
+}
+
+func (p *PriorityTransporter[T, P]) Add(element T) error {
+	item := p.itemFactory(element)
+	if item.IsEmpty() {
+		return common.ErrInvalidItem
+	}
+	p.queue.Push(item, p.priority(element))
+	return nil
+}
+
+func (p *PriorityTransporter[T, P]) IsEmpty() bool {
+	return p.queue.IsEmpty()
+}
+
 // NewTransporter creates a new generic Transporter based on the traversal strategy.
 func NewTransporter[T any](strategy TraversalStrategy, itemFactory common.ItemFactory[T]) Transporter[T] {
 	switch strategy {
@@ -82,3 +165,96 @@ func NewTransporter[T any](strategy TraversalStrategy, itemFactory common.ItemFa
 		return nil
 	}
 }
+
+// ConcurrentTransporter is a Transporter that is safe for use by multiple goroutines.
+// Besides the non-blocking Next, it lets consumers wait for work with NextCtx
+// and lets producers signal that no more work is coming with Close.
+type ConcurrentTransporter[T any] interface {
+	Transporter[T]
+	// NextCtx returns the next item, blocking until one is available.
+	// It returns the context's error if ctx is done first, or common.ErrClosed
+	// if the transporter is closed and no items remain.
+	NextCtx(ctx context.Context) (common.Item[T], error)
+	// Close wakes up every goroutine blocked in NextCtx and makes further calls to Add fail with common.ErrClosed.
+	Close()
+}
+
+// ConcurrentStackTransporter adapts a concurrent stack to implement the generic ConcurrentTransporter interface.
+// It is safe for use by multiple goroutines, which makes it suitable for parallel traversals.
+type ConcurrentStackTransporter[T any] struct {
+	stack       *simple_stack.ConcurrentStack[T, common.Item[T]]
+	itemFactory common.ItemFactory[T]
+}
+
+// NewConcurrentStackTransporter creates a new generic ConcurrentStackTransporter.
+func NewConcurrentStackTransporter[T any](itemFactory common.ItemFactory[T]) *ConcurrentStackTransporter[T] {
+	return &ConcurrentStackTransporter[T]{simple_stack.NewConcurrentStack[T, common.Item[T]](), itemFactory}
+}
+
+func (s *ConcurrentStackTransporter[T]) Next() (common.Item[T], error) {
+	return s.stack.Pop()
+}
+
+// NextCtx blocks until an item is available, ctx is done, or the transporter is closed.
+func (s *ConcurrentStackTransporter[T]) NextCtx(ctx context.Context) (common.Item[T], error) {
+	return s.stack.PopCtx(ctx)
+}
+
+func (s *ConcurrentStackTransporter[T]) Add(element T) error {
+	return s.stack.Push(s.itemFactory(element))
+}
+
+func (s *ConcurrentStackTransporter[T]) IsEmpty() bool {
+	return s.stack.IsEmpty()
+}
+
+// Close wakes up every goroutine blocked in NextCtx and makes further calls to Add fail with common.ErrClosed.
+func (s *ConcurrentStackTransporter[T]) Close() {
+	s.stack.Close()
+}
+
+// ConcurrentQueueTransporter adapts a concurrent queue to implement the generic ConcurrentTransporter interface.
+// It is safe for use by multiple goroutines, which makes it suitable for parallel traversals.
+type ConcurrentQueueTransporter[T any] struct {
+	queue       *simple_queue.ConcurrentQueue[T, common.Item[T]]
+	itemFactory common.ItemFactory[T]
+}
+
+// NewConcurrentQueueTransporter creates a new generic ConcurrentQueueTransporter.
+func NewConcurrentQueueTransporter[T any](itemFactory common.ItemFactory[T]) *ConcurrentQueueTransporter[T] {
+	return &ConcurrentQueueTransporter[T]{simple_queue.NewConcurrentQueue[T, common.Item[T]](), itemFactory}
+}
+
+func (q *ConcurrentQueueTransporter[T]) Next() (common.Item[T], error) {
+	return q.queue.Dequeue()
+}
+
+// NextCtx blocks until an item is available, ctx is done, or the transporter is closed.
+func (q *ConcurrentQueueTransporter[T]) NextCtx(ctx context.Context) (common.Item[T], error) {
+	return q.queue.DequeueCtx(ctx)
+}
+
+func (q *ConcurrentQueueTransporter[T]) Add(element T) error {
+	return q.queue.Enqueue(q.itemFactory(element))
+}
+
+func (q *ConcurrentQueueTransporter[T]) IsEmpty() bool {
+	return q.queue.IsEmpty()
+}
+
+// Close wakes up every goroutine blocked in NextCtx and makes further calls to Add fail with common.ErrClosed.
+func (q *ConcurrentQueueTransporter[T]) Close() {
+	q.queue.Close()
+}
+
+// NewConcurrentTransporter creates a new goroutine-safe ConcurrentTransporter based on the traversal strategy.
+func NewConcurrentTransporter[T any](strategy TraversalStrategy, itemFactory common.ItemFactory[T]) ConcurrentTransporter[T] {
+	switch strategy {
+	case StackTraversal:
+		return NewConcurrentStackTransporter(itemFactory)
+	case QueueTraversal:
+		return NewConcurrentQueueTransporter(itemFactory)
+	default:
+		return nil
+	}
+}
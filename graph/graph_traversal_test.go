@@ -0,0 +1,271 @@
+package graph_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sosalejandro/algo-practice/data-structures/common"
+	"github.com/sosalejandro/algo-practice/graph"
+)
+
+type stringItem struct {
+	value string
+}
+
+func (s *stringItem) Equals(other common.Item[string]) bool {
+	return s.value == other.Value()
+}
+
+func (s *stringItem) Value() string {
+	return s.value
+}
+
+func (s *stringItem) IsEmpty() bool {
+	return s.value == ""
+}
+
+var stringItemFactory common.ItemFactory[string] = func(value string) common.Item[string] {
+	return &stringItem{value: value}
+}
+
+func TestDequeTransporter(t *testing.T) {
+	tests := []struct {
+		name     string
+		strategy graph.TraversalStrategy
+		add      func(transporter *graph.DequeTransporter[string])
+		expected []string
+	}{
+		{
+			name:     "QueueTraversal returns items in insertion order",
+			strategy: graph.QueueTraversal,
+			add: func(transporter *graph.DequeTransporter[string]) {
+				transporter.Add("A")
+				transporter.Add("B")
+				transporter.Add("C")
+			},
+			expected: []string{"A", "B", "C"},
+		},
+		{
+			name:     "StackTraversal returns items in reverse insertion order",
+			strategy: graph.StackTraversal,
+			add: func(transporter *graph.DequeTransporter[string]) {
+				transporter.Add("A")
+				transporter.Add("B")
+				transporter.Add("C")
+			},
+			expected: []string{"C", "B", "A"},
+		},
+		{
+			name:     "AddFront jumps the queue",
+			strategy: graph.QueueTraversal,
+			add: func(transporter *graph.DequeTransporter[string]) {
+				transporter.Add("A")
+				transporter.Add("B")
+				transporter.AddFront("C")
+			},
+			expected: []string{"C", "A", "B"},
+		},
+		{
+			name:     "AddBack goes under the stack",
+			strategy: graph.StackTraversal,
+			add: func(transporter *graph.DequeTransporter[string]) {
+				transporter.Add("A")
+				transporter.Add("B")
+				transporter.AddBack("C")
+			},
+			expected: []string{"B", "A", "C"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			transporter := graph.NewDequeTransporter(tt.strategy, stringItemFactory)
+			tt.add(transporter)
+
+			result := make([]string, 0)
+			for !transporter.IsEmpty() {
+				item, err := transporter.Next()
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				result = append(result, item.Value())
+			}
+
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestPriorityTransporter(t *testing.T) {
+	tests := []struct {
+		name        string
+		priority    func(node string) int
+		add         []string
+		expected    []string
+		expectError error
+	}{
+		{
+			name:     "Returns lowest priority first",
+			priority: func(node string) int { return len(node) },
+			add:      []string{"CCC", "A", "BB"},
+			expected: []string{"A", "BB", "CCC"},
+		},
+		{
+			name:     "Reversed priority",
+			priority: func(node string) int { return -len(node) },
+			add:      []string{"CCC", "A", "BB"},
+			expected: []string{"CCC", "BB", "A"},
+		},
+		{
+			name:        "Rejects empty items",
+			priority:    func(node string) int { return len(node) },
+			add:         []string{""},
+			expected:    []string{},
+			expectError: common.ErrInvalidItem,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			transporter := graph.NewPriorityTransporter(tt.priority, stringItemFactory)
+			for _, node := range tt.add {
+				if err := transporter.Add(node); !errors.Is(err, tt.expectError) {
+					t.Fatalf("expected error %v, got %v", tt.expectError, err)
+				}
+			}
+
+			result := make([]string, 0)
+			for !transporter.IsEmpty() {
+				item, err := transporter.Next()
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				result = append(result, item.Value())
+			}
+
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, result)
+			}
+			if _, err := transporter.Next(); !errors.Is(err, common.ErrEmpty) {
+				t.Errorf("expected error %v, got %v", common.ErrEmpty, err)
+			}
+		})
+	}
+}
+
+var (
+	_ graph.ConcurrentTransporter[string] = (*graph.ConcurrentStackTransporter[string])(nil)
+	_ graph.ConcurrentTransporter[string] = (*graph.ConcurrentQueueTransporter[string])(nil)
+)
+
+func TestConcurrentTransporter_ProducersConsumers(t *testing.T) {
+	const producers, consumers, perProducer = 8, 8, 500
+
+	for _, strategy := range []graph.TraversalStrategy{graph.StackTraversal, graph.QueueTraversal} {
+		transporter := graph.NewConcurrentTransporter[string](strategy, stringItemFactory)
+
+		var producersDone sync.WaitGroup
+		for p := range producers {
+			producersDone.Add(1)
+			go func() {
+				defer producersDone.Done()
+				for i := range perProducer {
+					if err := transporter.Add(fmt.Sprintf("%d-%d", p, i)); err != nil {
+						t.Errorf("unexpected error: %v", err)
+					}
+				}
+			}()
+		}
+
+		var mu sync.Mutex
+		received := make(map[string]int)
+		var consumersDone sync.WaitGroup
+		for range consumers {
+			consumersDone.Add(1)
+			go func() {
+				defer consumersDone.Done()
+				for {
+					item, err := transporter.NextCtx(context.Background())
+					if errors.Is(err, common.ErrClosed) {
+						return
+					}
+					if err != nil {
+						t.Errorf("unexpected error: %v", err)
+						return
+					}
+					mu.Lock()
+					received[item.Value()]++
+					mu.Unlock()
+				}
+			}()
+		}
+
+		producersDone.Wait()
+		transporter.Close()
+		consumersDone.Wait()
+
+		if len(received) != producers*perProducer {
+			t.Errorf("expected %d distinct items, got %d", producers*perProducer, len(received))
+		}
+		for value, count := range received {
+			if count != 1 {
+				t.Errorf("expected %v to be received once, got %d", value, count)
+			}
+		}
+		if err := transporter.Add("late"); !errors.Is(err, common.ErrClosed) {
+			t.Errorf("expected %v, got %v", common.ErrClosed, err)
+		}
+	}
+}
+
+func TestConcurrentTransporter_CloseWakesWaiters(t *testing.T) {
+	for _, strategy := range []graph.TraversalStrategy{graph.StackTraversal, graph.QueueTraversal} {
+		transporter := graph.NewConcurrentTransporter[string](strategy, stringItemFactory)
+
+		const waiters = 4
+		errs := make(chan error, waiters)
+		for range waiters {
+			go func() {
+				_, err := transporter.NextCtx(context.Background())
+				errs <- err
+			}()
+		}
+
+		time.Sleep(10 * time.Millisecond)
+		transporter.Close()
+
+		for range waiters {
+			select {
+			case err := <-errs:
+				if !errors.Is(err, common.ErrClosed) {
+					t.Errorf("expected %v, got %v", common.ErrClosed, err)
+				}
+			case <-time.After(time.Second):
+				t.Fatalf("expected Close to wake every waiter")
+			}
+		}
+	}
+}
+
+func TestConcurrentTransporter_NextCtxCancelled(t *testing.T) {
+	transporter := graph.NewConcurrentTransporter[string](graph.QueueTraversal, stringItemFactory)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := transporter.NextCtx(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected %v, got %v", context.DeadlineExceeded, err)
+	}
+}
+
+func TestNewConcurrentTransporter_UnknownStrategy(t *testing.T) {
+	if transporter := graph.NewConcurrentTransporter[string](graph.TraversalStrategy(-1), stringItemFactory); transporter != nil {
+		t.Errorf("expected nil for an unknown strategy, got %v", transporter)
+	}
+}
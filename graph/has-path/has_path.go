@@ -43,8 +43,22 @@ var StringItemFactory common.ItemFactory[string] = func(value string) common.Ite
 // It returns true if there is a path from src to dst in the graph.
 // The graph (g) is represented as an adjacency list.
 // The itemFactory parameter is used to create items for the transporter.
-// The function returns an error if the transporter encounters an error.
+// The function returns an error if the transporter encounters an error, such as
+// common.ErrInvalidItem when itemFactory produces an empty item for a node.
 func HasPath[T comparable](strategy graph.TraversalStrategy, g map[T][]T, src, dst T, itemFactory common.ItemFactory[T]) (bool, error) {
+	transporter := graph.NewTransporter[T](strategy, itemFactory)
+	if transporter == nil {
+		return false, nil
+	}
+
+	return HasPathWithTransporter(transporter, g, src, dst)
+}
+
+// HasPathWithTransporter is like HasPath but explores the graph with the given transporter,
+// which makes it possible to plug in orders other than the built-in strategies,
+// such as a best-first search with a graph.PriorityTransporter.
+// The transporter should be empty.
+func HasPathWithTransporter[T comparable](transporter graph.Transporter[T], g map[T][]T, src, dst T) (bool, error) {
 	// Check if the source or destination node does not exist in the graph
 	if _, srcExists := g[src]; !srcExists {
 		return false, nil
@@ -57,11 +71,6 @@ func HasPath[T comparable](strategy graph.TraversalStrategy, g map[T][]T, src, d
 		return true, nil
 	}
 
-	transporter := graph.NewTransporter[T](strategy, itemFactory)
-	if transporter == nil {
-		return false, nil
-	}
-
 	visited := make(map[T]bool)
 	visited[src] = true
 
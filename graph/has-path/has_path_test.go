@@ -1,8 +1,10 @@
 package has_path
 
 import (
+	"errors"
 	"testing"
 
+	"github.com/sosalejandro/algo-practice/data-structures/common"
 	"github.com/sosalejandro/algo-practice/graph"
 )
 
@@ -153,5 +155,32 @@ func TestHasPath(t *testing.T) {
 				t.Errorf("expected %v, got %v", tt.expected, result)
 			}
 		})
+
+		t.Run(tt.name+"_PriorityTraversal", func(t *testing.T) {
+			transporter := graph.NewPriorityTransporter(func(node string) string { return node }, StringItemFactory)
+			result, err := HasPathWithTransporter(transporter, tt.graph, tt.src, tt.dst)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if result != tt.expected {
+				t.Errorf("expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestHasPath_InvalidItem(t *testing.T) {
+	g := map[string][]string{
+		"A": {"", "B"},
+		"":  {"C"},
+		"B": {"C"},
+		"C": {},
+	}
+
+	for _, strategy := range []graph.TraversalStrategy{graph.StackTraversal, graph.QueueTraversal} {
+		_, err := HasPath(strategy, g, "A", "C", StringItemFactory)
+		if !errors.Is(err, common.ErrInvalidItem) {
+			t.Errorf("expected error %v, got %v", common.ErrInvalidItem, err)
+		}
 	}
 }
@@ -0,0 +1,82 @@
+package max_flow
+
+import (
+	"github.com/sosalejandro/algo-practice/data-structures/common"
+	simple_queue "github.com/sosalejandro/algo-practice/data-structures/simple-queue"
+	"github.com/sosalejandro/algo-practice/graph"
+)
+
+// EdmondsKarp computes the maximum flow from source to sink by repeatedly sending flow along the shortest path
+// with spare capacity, found with a breadth-first search. It runs in O(V·E²) time.
+// It returns ErrNodeNotFound if source or sink is not in the network, or ErrSourceIsSink if they are the same node.
+func EdmondsKarp[T comparable, C graph.Weight](network *FlowNetwork[T, C], source, sink T) (*MaxFlow[T, C], error) {
+	s, t, err := network.validate(source, sink)
+	if err != nil {
+		return nil, err
+	}
+
+	flow := make([]C, len(network.to))
+	for {
+		path, err := network.augmentingPath(flow, s, t)
+		if err != nil {
+			return nil, err
+		}
+		if path == nil {
+			break
+		}
+
+		// The path can carry as much as its tightest edge
+		bottleneck := network.residual(flow, path[0])
+		for _, e := range path[1:] {
+			bottleneck = min(bottleneck, network.residual(flow, e))
+		}
+		for _, e := range path {
+			push(flow, e, bottleneck)
+		}
+	}
+
+	return network.result(flow, s), nil
+}
+
+// augmentingPath returns the edges of a shortest path from s to t with spare capacity,
+// or nil if there is none.
+func (n *FlowNetwork[T, C]) augmentingPath(flow []C, s, t int) ([]int, error) {
+	// via holds the edge each node was reached through, or -1 if it hasn't been reached
+	via := make([]int, len(n.nodes))
+	for i := range via {
+		via[i] = -1
+	}
+
+	queue := simple_queue.NewQueue[int, common.ValueItem[int]]()
+	if err := queue.Enqueue(common.NewValueItem(s)); err != nil {
+		return nil, err
+	}
+
+	for !queue.IsEmpty() && via[t] < 0 {
+		current, err := queue.Dequeue()
+		if err != nil {
+			return nil, err
+		}
+		u := current.Value()
+
+		for _, e := range n.adjacency[u] {
+			v := n.to[e]
+			if v == s || via[v] >= 0 || n.residual(flow, e) <= 0 {
+				continue
+			}
+			via[v] = e
+			if err := queue.Enqueue(common.NewValueItem(v)); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if via[t] < 0 {
+		return nil, nil
+	}
+	path := make([]int, 0)
+	for v := t; v != s; v = n.from[via[v]] {
+		path = append(path, via[v])
+	}
+	return path, nil
+}
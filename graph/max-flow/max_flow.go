@@ -0,0 +1,198 @@
+package max_flow
+
+import (
+	"errors"
+
+	"github.com/sosalejandro/algo-practice/graph"
+)
+
+var (
+	// ErrNegativeCapacity is returned when adding an edge with a negative capacity.
+	ErrNegativeCapacity = errors.New("edge capacity cannot be negative")
+	// ErrNodeNotFound is returned when the source or the sink is not part of the network.
+	ErrNodeNotFound = errors.New("node not found in flow network")
+	// ErrSourceIsSink is returned when the source and the sink are the same node.
+	ErrSourceIsSink = errors.New("source and sink must be different nodes")
+)
+
+// FlowNetwork is a directed graph whose edges have a capacity, the most flow they can carry.
+// It stores every edge together with a reverse residual edge of zero capacity, which the algorithms
+// use to undo flow. Running an algorithm does not modify the network, so it can be solved repeatedly.
+type FlowNetwork[T comparable, C graph.Weight] struct {
+	nodes []T
+	index map[T]int
+	// Edge 2i is the i-th added edge and edge 2i+1 is its residual reverse edge, so e^1 is always the partner of e.
+	from      []int
+	to        []int
+	capacity  []C
+	adjacency [][]int
+}
+
+// NewFlowNetwork creates an empty flow network.
+func NewFlowNetwork[T comparable, C graph.Weight]() *FlowNetwork[T, C] {
+	return &FlowNetwork[T, C]{
+		nodes:     make([]T, 0),
+		index:     make(map[T]int),
+		from:      make([]int, 0),
+		to:        make([]int, 0),
+		capacity:  make([]C, 0),
+		adjacency: make([][]int, 0),
+	}
+}
+
+// NewFlowNetworkFromGraph creates a flow network from a weighted graph, using edge weights as capacities.
+// Edges of a Bidirectional graph can carry flow in both directions, each up to its capacity.
+// It returns ErrNegativeCapacity if any edge has a negative weight.
+func NewFlowNetworkFromGraph[T comparable, C graph.Weight](g *graph.WeightedGraph[T, C]) (*FlowNetwork[T, C], error) {
+	network := NewFlowNetwork[T, C]()
+	for _, node := range g.Nodes() {
+		network.AddNode(node)
+	}
+	for _, edge := range g.Edges() {
+		if err := network.AddEdge(edge.Src, edge.Dst, edge.Weight); err != nil {
+			return nil, err
+		}
+		if g.Type() == graph.Bidirectional {
+			if err := network.AddEdge(edge.Dst, edge.Src, edge.Weight); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return network, nil
+}
+
+// AddNode adds a node without edges. Adding an existing node has no effect.
+func (n *FlowNetwork[T, C]) AddNode(node T) {
+	if _, exists := n.index[node]; exists {
+		return
+	}
+	n.index[node] = len(n.nodes)
+	n.nodes = append(n.nodes, node)
+	n.adjacency = append(n.adjacency, make([]int, 0))
+}
+
+// AddEdge adds an edge from src to dst that can carry up to capacity, adding either node if it doesn't yet exist.
+// Parallel edges add up their capacities.
+// It returns ErrNegativeCapacity if capacity is negative.
+func (n *FlowNetwork[T, C]) AddEdge(src, dst T, capacity C) error {
+	if capacity < 0 {
+		return ErrNegativeCapacity
+	}
+	n.AddNode(src)
+	n.AddNode(dst)
+	u, v := n.index[src], n.index[dst]
+
+	n.adjacency[u] = append(n.adjacency[u], len(n.to))
+	n.from = append(n.from, u)
+	n.to = append(n.to, v)
+	n.capacity = append(n.capacity, capacity)
+
+	n.adjacency[v] = append(n.adjacency[v], len(n.to))
+	n.from = append(n.from, v)
+	n.to = append(n.to, u)
+	n.capacity = append(n.capacity, 0)
+	return nil
+}
+
+// Nodes returns every node of the network in insertion order.
+func (n *FlowNetwork[T, C]) Nodes() []T {
+	result := make([]T, len(n.nodes))
+	copy(result, n.nodes)
+	return result
+}
+
+// EdgeFlow is the flow an algorithm sent through one edge of a FlowNetwork.
+type EdgeFlow[T comparable, C graph.Weight] struct {
+	Src      T
+	Dst      T
+	Capacity C
+	Flow     C
+}
+
+// MaxFlow is the result of a maximum flow computation.
+type MaxFlow[T comparable, C graph.Weight] struct {
+	// Value is the total flow leaving the source, which equals the capacity of the minimum cut.
+	Value C
+	// Flows holds the flow through every edge, in the order the edges were added to the network.
+	Flows []EdgeFlow[T, C]
+	// SourceSide holds the nodes still reachable from the source through edges with spare capacity,
+	// and SinkSide every other node. Both keep the insertion order of the network.
+	SourceSide []T
+	SinkSide   []T
+	// CutEdges holds the edges from the source side to the sink side. They are all saturated,
+	// and their capacities add up to Value.
+	CutEdges []EdgeFlow[T, C]
+}
+
+// validate returns the indices of source and sink, or an error if they can't be used.
+func (n *FlowNetwork[T, C]) validate(source, sink T) (int, int, error) {
+	s, sourceExists := n.index[source]
+	t, sinkExists := n.index[sink]
+	if !sourceExists || !sinkExists {
+		return 0, 0, ErrNodeNotFound
+	}
+	if s == t {
+		return 0, 0, ErrSourceIsSink
+	}
+	return s, t, nil
+}
+
+// residual returns the capacity left on edge e given the current flows.
+func (n *FlowNetwork[T, C]) residual(flow []C, e int) C {
+	return n.capacity[e] - flow[e]
+}
+
+// push sends amount more flow through edge e, taking it back from the partner edge.
+func push[C graph.Weight](flow []C, e int, amount C) {
+	flow[e] += amount
+	flow[e^1] -= amount
+}
+
+// result builds the MaxFlow for the given flows, finding the minimum cut with a search
+// from the source over edges that still have spare capacity.
+func (n *FlowNetwork[T, C]) result(flow []C, s int) *MaxFlow[T, C] {
+	reachable := make([]bool, len(n.nodes))
+	reachable[s] = true
+	stack := []int{s}
+	for len(stack) > 0 {
+		u := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		for _, e := range n.adjacency[u] {
+			if v := n.to[e]; !reachable[v] && n.residual(flow, e) > 0 {
+				reachable[v] = true
+				stack = append(stack, v)
+			}
+		}
+	}
+
+	maxFlow := &MaxFlow[T, C]{
+		Flows:      make([]EdgeFlow[T, C], 0, len(n.to)/2),
+		SourceSide: make([]T, 0),
+		SinkSide:   make([]T, 0),
+		CutEdges:   make([]EdgeFlow[T, C], 0),
+	}
+	for e := 0; e < len(n.to); e += 2 {
+		edgeFlow := EdgeFlow[T, C]{
+			Src:      n.nodes[n.from[e]],
+			Dst:      n.nodes[n.to[e]],
+			Capacity: n.capacity[e],
+			Flow:     flow[e],
+		}
+		maxFlow.Flows = append(maxFlow.Flows, edgeFlow)
+		if reachable[n.from[e]] && !reachable[n.to[e]] {
+			maxFlow.CutEdges = append(maxFlow.CutEdges, edgeFlow)
+		}
+	}
+	// Residual edges carry the negated flow of the edges they reverse, so this is the net flow out of the source
+	for _, e := range n.adjacency[s] {
+		maxFlow.Value += flow[e]
+	}
+	for i, node := range n.nodes {
+		if reachable[i] {
+			maxFlow.SourceSide = append(maxFlow.SourceSide, node)
+		} else {
+			maxFlow.SinkSide = append(maxFlow.SinkSide, node)
+		}
+	}
+	return maxFlow
+}
@@ -0,0 +1,275 @@
+package max_flow
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/sosalejandro/algo-practice/graph"
+)
+
+type maxFlowFunc func(network *FlowNetwork[string, int], source, sink string) (*MaxFlow[string, int], error)
+
+var algorithms = map[string]maxFlowFunc{
+	"EdmondsKarp": EdmondsKarp[string, int],
+	"Dinic":       Dinic[string, int],
+}
+
+func generateNetwork(t *testing.T, edges []graph.WeightedEdge[string, int]) *FlowNetwork[string, int] {
+	t.Helper()
+	network := NewFlowNetwork[string, int]()
+	for _, edge := range edges {
+		if err := network.AddEdge(edge.Src, edge.Dst, edge.Weight); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	return network
+}
+
+func TestMaxFlow(t *testing.T) {
+	tests := []struct {
+		name       string
+		edges      []graph.WeightedEdge[string, int]
+		source     string
+		sink       string
+		value      int
+		sourceSide []string
+	}{
+		{
+			name: "Classic Network",
+			edges: []graph.WeightedEdge[string, int]{
+				{Src: "s", Dst: "v1", Weight: 16},
+				{Src: "s", Dst: "v2", Weight: 13},
+				{Src: "v2", Dst: "v1", Weight: 4},
+				{Src: "v1", Dst: "v3", Weight: 12},
+				{Src: "v3", Dst: "v2", Weight: 9},
+				{Src: "v2", Dst: "v4", Weight: 14},
+				{Src: "v4", Dst: "v3", Weight: 7},
+				{Src: "v3", Dst: "t", Weight: 20},
+				{Src: "v4", Dst: "t", Weight: 4},
+			},
+			source:     "s",
+			sink:       "t",
+			value:      23,
+			sourceSide: []string{"s", "v1", "v2", "v4"},
+		},
+		{
+			name: "Flow Must Be Rerouted",
+			edges: []graph.WeightedEdge[string, int]{
+				{Src: "s", Dst: "a", Weight: 1},
+				{Src: "s", Dst: "b", Weight: 1},
+				{Src: "a", Dst: "b", Weight: 1},
+				{Src: "a", Dst: "t", Weight: 1},
+				{Src: "b", Dst: "t", Weight: 1},
+			},
+			source:     "s",
+			sink:       "t",
+			value:      2,
+			sourceSide: []string{"s"},
+		},
+		{
+			name: "Parallel Edges Add Up",
+			edges: []graph.WeightedEdge[string, int]{
+				{Src: "s", Dst: "t", Weight: 3},
+				{Src: "s", Dst: "t", Weight: 4},
+			},
+			source:     "s",
+			sink:       "t",
+			value:      7,
+			sourceSide: []string{"s"},
+		},
+		{
+			name: "Sink Unreachable",
+			edges: []graph.WeightedEdge[string, int]{
+				{Src: "s", Dst: "a", Weight: 5},
+				{Src: "t", Dst: "a", Weight: 5},
+			},
+			source:     "s",
+			sink:       "t",
+			value:      0,
+			sourceSide: []string{"s", "a"},
+		},
+	}
+
+	for name, algorithm := range algorithms {
+		for _, tt := range tests {
+			t.Run(name+"/"+tt.name, func(t *testing.T) {
+				network := generateNetwork(t, tt.edges)
+
+				result, err := algorithm(network, tt.source, tt.sink)
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if result.Value != tt.value {
+					t.Errorf("expected flow %v, got %v", tt.value, result.Value)
+				}
+				if !reflect.DeepEqual(result.SourceSide, tt.sourceSide) {
+					t.Errorf("expected source side %v, got %v", tt.sourceSide, result.SourceSide)
+				}
+				if len(result.SourceSide)+len(result.SinkSide) != len(network.Nodes()) {
+					t.Errorf("expected the cut to partition every node, got %v and %v", result.SourceSide, result.SinkSide)
+				}
+				checkFlows(t, result, tt.edges, tt.source, tt.sink)
+			})
+		}
+	}
+}
+
+func TestMaxFlow_FromGraph(t *testing.T) {
+	g := graph.GenerateWeightedGraphFromEdges([]graph.WeightedEdge[string, float64]{
+		{Src: "s", Dst: "a", Weight: 1.5},
+		{Src: "a", Dst: "t", Weight: 2.5},
+		{Src: "s", Dst: "b", Weight: 2},
+		{Src: "b", Dst: "a", Weight: 1},
+	}, graph.Bidirectional)
+
+	network, err := NewFlowNetworkFromGraph(g)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for name, algorithm := range map[string]func(*FlowNetwork[string, float64], string, string) (*MaxFlow[string, float64], error){
+		"EdmondsKarp": EdmondsKarp[string, float64],
+		"Dinic":       Dinic[string, float64],
+	} {
+		result, err := algorithm(network, "t", "s")
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", name, err)
+		}
+		if result.Value != 2.5 {
+			t.Errorf("%s: expected flow 2.5 against the edges' original direction, got %v", name, result.Value)
+		}
+	}
+}
+
+func TestMaxFlow_Errors(t *testing.T) {
+	network := NewFlowNetwork[string, int]()
+	if err := network.AddEdge("s", "t", -1); !errors.Is(err, ErrNegativeCapacity) {
+		t.Errorf("expected %v, got %v", ErrNegativeCapacity, err)
+	}
+	if err := network.AddEdge("s", "t", 1); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for name, algorithm := range algorithms {
+		if _, err := algorithm(network, "s", "x"); !errors.Is(err, ErrNodeNotFound) {
+			t.Errorf("%s: expected %v, got %v", name, ErrNodeNotFound, err)
+		}
+		if _, err := algorithm(network, "s", "s"); !errors.Is(err, ErrSourceIsSink) {
+			t.Errorf("%s: expected %v, got %v", name, ErrSourceIsSink, err)
+		}
+	}
+
+	g := graph.GenerateWeightedGraphFromEdges([]graph.WeightedEdge[string, int]{{Src: "s", Dst: "t", Weight: -2}}, graph.Directional)
+	if _, err := NewFlowNetworkFromGraph(g); !errors.Is(err, ErrNegativeCapacity) {
+		t.Errorf("expected %v, got %v", ErrNegativeCapacity, err)
+	}
+}
+
+func TestMaxFlow_RepeatedRuns(t *testing.T) {
+	network := generateNetwork(t, []graph.WeightedEdge[string, int]{
+		{Src: "s", Dst: "a", Weight: 3},
+		{Src: "a", Dst: "t", Weight: 2},
+	})
+
+	for range 3 {
+		for name, algorithm := range algorithms {
+			result, err := algorithm(network, "s", "t")
+			if err != nil {
+				t.Fatalf("%s: unexpected error: %v", name, err)
+			}
+			if result.Value != 2 {
+				t.Errorf("%s: expected every run to find flow 2, got %v", name, result.Value)
+			}
+		}
+	}
+}
+
+func TestMaxFlow_SourceCapacitiesOverflow(t *testing.T) {
+	wide := NewFlowNetwork[string, int64]()
+	for _, edge := range []graph.WeightedEdge[string, int64]{
+		{Src: "s", Dst: "a", Weight: math.MaxInt64},
+		{Src: "s", Dst: "b", Weight: math.MaxInt64},
+		{Src: "a", Dst: "t", Weight: 3},
+		{Src: "b", Dst: "t", Weight: 4},
+	} {
+		if err := wide.AddEdge(edge.Src, edge.Dst, edge.Weight); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	edmondsKarp, err := EdmondsKarp(wide, "s", "t")
+	if err != nil {
+		t.Fatalf("EdmondsKarp: unexpected error: %v", err)
+	}
+	dinic, err := Dinic(wide, "s", "t")
+	if err != nil {
+		t.Fatalf("Dinic: unexpected error: %v", err)
+	}
+	if edmondsKarp.Value != 7 || dinic.Value != edmondsKarp.Value {
+		t.Errorf("expected both algorithms to find flow 7, got %v and %v", edmondsKarp.Value, dinic.Value)
+	}
+
+	// Two source edges of 128 add up to exactly 0 in a uint8
+	narrow := NewFlowNetwork[string, uint8]()
+	for _, edge := range []graph.WeightedEdge[string, uint8]{
+		{Src: "s", Dst: "a", Weight: 128},
+		{Src: "s", Dst: "b", Weight: 128},
+		{Src: "a", Dst: "t", Weight: 5},
+		{Src: "b", Dst: "t", Weight: 6},
+	} {
+		if err := narrow.AddEdge(edge.Src, edge.Dst, edge.Weight); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	result, err := Dinic(narrow, "s", "t")
+	if err != nil {
+		t.Fatalf("Dinic: unexpected error: %v", err)
+	}
+	if result.Value != 11 {
+		t.Errorf("expected flow 11, got %v", result.Value)
+	}
+}
+
+// checkFlows checks that every edge respects its capacity, that flow is conserved at every node
+// but the source and the sink, and that the cut edges are saturated and add up to the flow value.
+func checkFlows(t *testing.T, result *MaxFlow[string, int], edges []graph.WeightedEdge[string, int], source, sink string) {
+	t.Helper()
+
+	if len(result.Flows) != len(edges) {
+		t.Fatalf("expected %d edge flows, got %d", len(edges), len(result.Flows))
+	}
+
+	balance := make(map[string]int)
+	for i, edgeFlow := range result.Flows {
+		if edgeFlow.Src != edges[i].Src || edgeFlow.Dst != edges[i].Dst || edgeFlow.Capacity != edges[i].Weight {
+			t.Errorf("expected flow %d to describe edge %v, got %v", i, edges[i], edgeFlow)
+		}
+		if edgeFlow.Flow < 0 || edgeFlow.Flow > edgeFlow.Capacity {
+			t.Errorf("expected flow within [0, %d] on %v -> %v, got %d", edgeFlow.Capacity, edgeFlow.Src, edgeFlow.Dst, edgeFlow.Flow)
+		}
+		balance[edgeFlow.Src] -= edgeFlow.Flow
+		balance[edgeFlow.Dst] += edgeFlow.Flow
+	}
+	for node, net := range balance {
+		if node != source && node != sink && net != 0 {
+			t.Errorf("expected flow to be conserved at %v, got a net inflow of %d", node, net)
+		}
+	}
+	if balance[sink] != result.Value {
+		t.Errorf("expected the sink to receive %d, got %d", result.Value, balance[sink])
+	}
+
+	cut := 0
+	for _, edgeFlow := range result.CutEdges {
+		if edgeFlow.Flow != edgeFlow.Capacity {
+			t.Errorf("expected cut edge %v -> %v to be saturated", edgeFlow.Src, edgeFlow.Dst)
+		}
+		cut += edgeFlow.Capacity
+	}
+	if cut != result.Value {
+		t.Errorf("expected the minimum cut capacity %d to equal the flow %d", cut, result.Value)
+	}
+}
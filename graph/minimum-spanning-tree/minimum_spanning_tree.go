@@ -0,0 +1,121 @@
+package minimum_spanning_tree
+
+import (
+	"cmp"
+	"errors"
+	"slices"
+
+	priority_queue "github.com/sosalejandro/algo-practice/data-structures/priority-queue"
+	union_find "github.com/sosalejandro/algo-practice/data-structures/union-find"
+	"github.com/sosalejandro/algo-practice/graph"
+)
+
+// ErrDirectedGraph is returned when a spanning tree is requested for a graph that is not Bidirectional.
+var ErrDirectedGraph = errors.New("minimum spanning trees require a bidirectional graph")
+
+// SpanningForest is a minimum spanning forest: one minimum spanning tree for every connected component of a graph.
+// For a connected graph it is a single minimum spanning tree.
+type SpanningForest[T comparable, W graph.Weight] struct {
+	// Edges holds the chosen edges, in the order they were added to the forest.
+	Edges []graph.WeightedEdge[T, W]
+	// Weight is the sum of the weights of the chosen edges.
+	Weight W
+	// Trees is the number of trees in the forest, which is the number of connected components of the graph.
+	// Isolated nodes count as trees of their own.
+	Trees int
+}
+
+// IsSpanningTree checks if the forest is a single tree, that is, if the graph was connected.
+func (f *SpanningForest[T, W]) IsSpanningTree() bool {
+	return f.Trees <= 1
+}
+
+// Kruskal finds a minimum spanning forest using Kruskal's algorithm: edges are considered from the cheapest up
+// and kept whenever they join two different trees, which a union-find tracks.
+// Edges of equal weight are considered in the order they were added to the graph.
+// It returns ErrDirectedGraph if g is not Bidirectional.
+func Kruskal[T comparable, W graph.Weight](g *graph.WeightedGraph[T, W]) (*SpanningForest[T, W], error) {
+	if g.Type() != graph.Bidirectional {
+		return nil, ErrDirectedGraph
+	}
+
+	edges := g.Edges()
+	slices.SortStableFunc(edges, func(a, b graph.WeightedEdge[T, W]) int { return cmp.Compare(a.Weight, b.Weight) })
+
+	trees := union_find.NewUnionFind[T]()
+	for _, node := range g.Nodes() {
+		trees.Add(node)
+	}
+
+	forest := &SpanningForest[T, W]{Edges: make([]graph.WeightedEdge[T, W], 0)}
+	for _, edge := range edges {
+		if trees.Union(edge.Src, edge.Dst) {
+			forest.Edges = append(forest.Edges, edge)
+			forest.Weight += edge.Weight
+		}
+	}
+	forest.Trees = trees.Count()
+
+	return forest, nil
+}
+
+// Prim finds a minimum spanning forest using Prim's algorithm: every tree grows from a single node
+// by repeatedly adding the cheapest edge leading out of it, which a priority queue keeps track of.
+// Trees are grown from the nodes in the order they were added to the graph.
+// It returns ErrDirectedGraph if g is not Bidirectional.
+func Prim[T comparable, W graph.Weight](g *graph.WeightedGraph[T, W]) (*SpanningForest[T, W], error) {
+	if g.Type() != graph.Bidirectional {
+		return nil, ErrDirectedGraph
+	}
+
+	forest := &SpanningForest[T, W]{Edges: make([]graph.WeightedEdge[T, W], 0)}
+	inTree := make(map[T]bool, g.NodeCount())
+
+	for _, root := range g.Nodes() {
+		if inTree[root] {
+			continue
+		}
+		forest.Trees++
+
+		// Every node next to the tree is queued by the weight of the cheapest edge joining it to the tree,
+		// which cheapest remembers so the edge can be added when the node is.
+		queue := priority_queue.NewPriorityQueue[T, W]()
+		handles := map[T]*priority_queue.Handle[T, W]{root: queue.Push(root, 0)}
+		cheapest := make(map[T]graph.WeightedEdge[T, W])
+
+		for !queue.IsEmpty() {
+			current, err := queue.Pop()
+			if err != nil {
+				return nil, err
+			}
+			node := current.Value()
+			inTree[node] = true
+			if edge, exists := cheapest[node]; exists {
+				forest.Edges = append(forest.Edges, edge)
+				forest.Weight += edge.Weight
+			}
+
+			for _, neighbor := range g.Neighbors(node) {
+				if inTree[neighbor.Node] {
+					continue
+				}
+
+				edge := graph.WeightedEdge[T, W]{Src: node, Dst: neighbor.Node, Weight: neighbor.Weight}
+				handle, queued := handles[neighbor.Node]
+				if !queued {
+					handles[neighbor.Node] = queue.Push(neighbor.Node, neighbor.Weight)
+					cheapest[neighbor.Node] = edge
+					continue
+				}
+				if neighbor.Weight < handle.Priority() {
+					if err := queue.DecreaseKey(handle, neighbor.Weight); err != nil {
+						return nil, err
+					}
+					cheapest[neighbor.Node] = edge
+				}
+			}
+		}
+	}
+
+	return forest, nil
+}
@@ -0,0 +1,139 @@
+package minimum_spanning_tree
+
+import (
+	"cmp"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/sosalejandro/algo-practice/graph"
+)
+
+type spanningForestFunc func(g *graph.WeightedGraph[string, int]) (*SpanningForest[string, int], error)
+
+var algorithms = map[string]spanningForestFunc{
+	"Kruskal": Kruskal[string, int],
+	"Prim":    Prim[string, int],
+}
+
+func TestMinimumSpanningForest(t *testing.T) {
+	tests := []struct {
+		name   string
+		edges  []graph.WeightedEdge[string, int]
+		nodes  []string
+		weight int
+		trees  int
+		chosen [][2]string
+	}{
+		{
+			name:   "Empty Graph",
+			edges:  []graph.WeightedEdge[string, int]{},
+			weight: 0,
+			trees:  0,
+			chosen: [][2]string{},
+		},
+		{
+			name: "Connected Graph",
+			edges: []graph.WeightedEdge[string, int]{
+				{Src: "A", Dst: "B", Weight: 7},
+				{Src: "A", Dst: "D", Weight: 5},
+				{Src: "B", Dst: "C", Weight: 8},
+				{Src: "B", Dst: "D", Weight: 9},
+				{Src: "B", Dst: "E", Weight: 7},
+				{Src: "C", Dst: "E", Weight: 5},
+				{Src: "D", Dst: "E", Weight: 15},
+				{Src: "D", Dst: "F", Weight: 6},
+				{Src: "E", Dst: "F", Weight: 8},
+				{Src: "E", Dst: "G", Weight: 9},
+				{Src: "F", Dst: "G", Weight: 11},
+			},
+			weight: 39,
+			trees:  1,
+			chosen: [][2]string{{"A", "B"}, {"A", "D"}, {"B", "E"}, {"C", "E"}, {"D", "F"}, {"E", "G"}},
+		},
+		{
+			name: "Disconnected Graph",
+			edges: []graph.WeightedEdge[string, int]{
+				{Src: "A", Dst: "B", Weight: 1},
+				{Src: "B", Dst: "C", Weight: 2},
+				{Src: "A", Dst: "C", Weight: 3},
+				{Src: "X", Dst: "Y", Weight: 4},
+			},
+			nodes:  []string{"Z"},
+			weight: 7,
+			trees:  3,
+			chosen: [][2]string{{"A", "B"}, {"B", "C"}, {"X", "Y"}},
+		},
+		{
+			name: "Parallel Edges And Self Loops",
+			edges: []graph.WeightedEdge[string, int]{
+				{Src: "A", Dst: "A", Weight: -5},
+				{Src: "A", Dst: "B", Weight: 4},
+				{Src: "B", Dst: "A", Weight: 2},
+			},
+			weight: 2,
+			trees:  1,
+			chosen: [][2]string{{"A", "B"}},
+		},
+	}
+
+	for name, algorithm := range algorithms {
+		for _, tt := range tests {
+			t.Run(name+"/"+tt.name, func(t *testing.T) {
+				g := graph.GenerateWeightedGraphFromEdges(tt.edges, graph.Bidirectional)
+				for _, node := range tt.nodes {
+					g.AddNode(node)
+				}
+
+				forest, err := algorithm(g)
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if forest.Weight != tt.weight {
+					t.Errorf("expected weight %v, got %v", tt.weight, forest.Weight)
+				}
+				if forest.Trees != tt.trees {
+					t.Errorf("expected %v trees, got %v", tt.trees, forest.Trees)
+				}
+				if forest.IsSpanningTree() != (tt.trees <= 1) {
+					t.Errorf("expected IsSpanningTree to be %v", tt.trees <= 1)
+				}
+				if got := normalize(forest.Edges); !slices.Equal(got, tt.chosen) {
+					t.Errorf("expected edges %v, got %v", tt.chosen, got)
+				}
+				if len(forest.Edges) != g.NodeCount()-forest.Trees {
+					t.Errorf("expected a forest of %d trees over %d nodes to have %d edges, got %d",
+						forest.Trees, g.NodeCount(), g.NodeCount()-forest.Trees, len(forest.Edges))
+				}
+			})
+		}
+	}
+}
+
+func TestMinimumSpanningForest_DirectedGraph(t *testing.T) {
+	g := graph.GenerateWeightedGraphFromEdges([]graph.WeightedEdge[string, int]{
+		{Src: "A", Dst: "B", Weight: 1},
+	}, graph.Directional)
+
+	for name, algorithm := range algorithms {
+		if _, err := algorithm(g); !errors.Is(err, ErrDirectedGraph) {
+			t.Errorf("%s: expected %v, got %v", name, ErrDirectedGraph, err)
+		}
+	}
+}
+
+// normalize returns the endpoints of every edge with the smaller node first, sorted, so results can be compared
+// regardless of the direction and order the algorithms add edges in.
+func normalize(edges []graph.WeightedEdge[string, int]) [][2]string {
+	result := make([][2]string, 0, len(edges))
+	for _, edge := range edges {
+		result = append(result, [2]string{min(edge.Src, edge.Dst), max(edge.Src, edge.Dst)})
+	}
+	slices.SortFunc(result, func(a, b [2]string) int {
+		if a[0] != b[0] {
+			return cmp.Compare(a[0], b[0])
+		}
+		return cmp.Compare(a[1], b[1])
+	})
+	return result
+}
@@ -0,0 +1,111 @@
+package shortest_path
+
+import (
+	"slices"
+
+	"github.com/sosalejandro/algo-practice/data-structures/common"
+	"github.com/sosalejandro/algo-practice/graph"
+)
+
+// ShortestPath returns the path from src to dst with the fewest edges, both ends included,
+// using a breadth-first search over the adjacency list g.
+// It returns a nil path if dst cannot be reached from src or either node is not in the graph.
+// The itemFactory parameter is used to create items for the queue.
+// The function returns an error if the queue encounters an error, such as
+// common.ErrInvalidItem when itemFactory produces an empty item for a node.
+func ShortestPath[T comparable](g map[T][]T, src, dst T, itemFactory common.ItemFactory[T]) ([]T, error) {
+	return ShortestPathWithTransporter[T](graph.NewQueueTransporter(itemFactory), g, src, dst)
+}
+
+// ShortestPathWithTransporter is like ShortestPath but explores the graph with the given transporter.
+// The transporter must return items in insertion order, like a graph.QueueTransporter,
+// otherwise the path found is not necessarily the shortest. The transporter should be empty.
+func ShortestPathWithTransporter[T comparable](transporter graph.Transporter[T], g map[T][]T, src, dst T) ([]T, error) {
+	distances, predecessors, err := search(transporter, g, src, func(node T) bool { return node == dst })
+	if err != nil {
+		return nil, err
+	}
+	if _, reached := distances[dst]; !reached {
+		return nil, nil
+	}
+
+	path := []T{dst}
+	for node := dst; node != src; {
+		node = predecessors[node]
+		path = append(path, node)
+	}
+	slices.Reverse(path)
+	return path, nil
+}
+
+// Distances returns the number of edges on the shortest path from src to every node reachable from it,
+// src included with a distance of zero, using a breadth-first search over the adjacency list g.
+// It returns an empty map if src is not in the graph.
+// The itemFactory parameter is used to create items for the queue.
+// The function returns an error if the queue encounters an error, such as
+// common.ErrInvalidItem when itemFactory produces an empty item for a node.
+func Distances[T comparable](g map[T][]T, src T, itemFactory common.ItemFactory[T]) (map[T]int, error) {
+	return DistancesWithTransporter[T](graph.NewQueueTransporter(itemFactory), g, src)
+}
+
+// DistancesWithTransporter is like Distances but explores the graph with the given transporter.
+// The transporter must return items in insertion order, like a graph.QueueTransporter,
+// otherwise the distances are not necessarily the shortest. The transporter should be empty.
+func DistancesWithTransporter[T comparable](transporter graph.Transporter[T], g map[T][]T, src T) (map[T]int, error) {
+	distances, _, err := search(transporter, g, src, func(T) bool { return false })
+	if err != nil {
+		return nil, err
+	}
+	return distances, nil
+}
+
+// search runs a breadth-first search from src, recording the distance and predecessor of every node it reaches,
+// until the transporter runs out of nodes or done reports true for a reached node.
+func search[T comparable](transporter graph.Transporter[T], g map[T][]T, src T, done func(T) bool) (map[T]int, map[T]T, error) {
+	distances := make(map[T]int)
+	predecessors := make(map[T]T)
+
+	// Check if the source node does not exist in the graph
+	if _, srcExists := g[src]; !srcExists {
+		return distances, predecessors, nil
+	}
+
+	distances[src] = 0
+	if done(src) {
+		return distances, predecessors, nil
+	}
+
+	// Initialize traversal by adding the source node to the transporter
+	if err := transporter.Add(src); err != nil {
+		return nil, nil, err
+	}
+
+	for !transporter.IsEmpty() {
+		currentItem, err := transporter.Next()
+		if err != nil {
+			return nil, nil, err
+		}
+		current := currentItem.Value()
+
+		for _, neighbor := range g[current] {
+			// Check if the neighbor exists in the graph
+			if _, neighborExists := g[neighbor]; !neighborExists {
+				continue
+			}
+			if _, visited := distances[neighbor]; visited {
+				continue
+			}
+
+			distances[neighbor] = distances[current] + 1
+			predecessors[neighbor] = current
+			if done(neighbor) {
+				return distances, predecessors, nil
+			}
+			if err := transporter.Add(neighbor); err != nil {
+				return nil, nil, err
+			}
+		}
+	}
+
+	return distances, predecessors, nil
+}
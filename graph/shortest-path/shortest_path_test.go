@@ -0,0 +1,115 @@
+package shortest_path
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/sosalejandro/algo-practice/data-structures/common"
+	"github.com/sosalejandro/algo-practice/graph"
+)
+
+func generateGraph() map[string][]string {
+	return graph.GenerateGraphFromEdges([][]string{
+		{"A", "B"},
+		{"A", "C"},
+		{"B", "D"},
+		{"C", "E"},
+		{"E", "D"},
+		{"D", "F"},
+		{"G", "H"},
+	}, graph.Directional)
+}
+
+func TestShortestPath(t *testing.T) {
+	tests := []struct {
+		name     string
+		graph    map[string][]string
+		src      string
+		dst      string
+		expected []string
+	}{
+		{name: "Empty Graph", graph: map[string][]string{}, src: "A", dst: "B", expected: nil},
+		{name: "Source Is Destination", graph: generateGraph(), src: "A", dst: "A", expected: []string{"A"}},
+		{name: "Direct Edge", graph: generateGraph(), src: "A", dst: "B", expected: []string{"A", "B"}},
+		{name: "Fewest Hops Wins", graph: generateGraph(), src: "A", dst: "F", expected: []string{"A", "B", "D", "F"}},
+		{name: "Edge Direction Is Respected", graph: generateGraph(), src: "F", dst: "A", expected: nil},
+		{name: "Disconnected Component", graph: generateGraph(), src: "A", dst: "H", expected: nil},
+		{name: "Unknown Destination", graph: generateGraph(), src: "A", dst: "Z", expected: nil},
+		{
+			name:     "Bidirectional Graph",
+			graph:    graph.GenerateGraphFromEdges([][]string{{"A", "B"}, {"B", "C"}, {"C", "D"}, {"A", "D"}}, graph.Bidirectional),
+			src:      "C",
+			dst:      "A",
+			expected: []string{"C", "B", "A"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := ShortestPath(tt.graph, tt.src, tt.dst, common.ValueItemFactory[string])
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, result)
+			}
+		})
+
+		t.Run(tt.name+"_DequeTransporter", func(t *testing.T) {
+			transporter := graph.NewDequeTransporter[string](graph.QueueTraversal, common.ValueItemFactory[string])
+			result, err := ShortestPathWithTransporter[string](transporter, tt.graph, tt.src, tt.dst)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestDistances(t *testing.T) {
+	tests := []struct {
+		name     string
+		graph    map[string][]string
+		src      string
+		expected map[string]int
+	}{
+		{name: "Empty Graph", graph: map[string][]string{}, src: "A", expected: map[string]int{}},
+		{name: "Directional Graph", graph: generateGraph(), src: "A", expected: map[string]int{"A": 0, "B": 1, "C": 1, "D": 2, "E": 2, "F": 3}},
+		{name: "Sink Node", graph: generateGraph(), src: "F", expected: map[string]int{"F": 0}},
+		{
+			name:     "Bidirectional Graph",
+			graph:    graph.GenerateGraphFromEdges([][]string{{"A", "B"}, {"B", "C"}, {"C", "D"}, {"A", "D"}}, graph.Bidirectional),
+			src:      "A",
+			expected: map[string]int{"A": 0, "B": 1, "C": 2, "D": 1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := Distances(tt.graph, tt.src, common.ValueItemFactory[string])
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestShortestPath_InvalidItem(t *testing.T) {
+	g := map[string][]string{
+		"A": {""},
+		"":  {},
+	}
+
+	if _, err := ShortestPath(g, "A", "B", common.NonZeroItemFactory[string]); !errors.Is(err, common.ErrInvalidItem) {
+		t.Errorf("expected %v, got %v", common.ErrInvalidItem, err)
+	}
+	if _, err := Distances(g, "A", common.NonZeroItemFactory[string]); !errors.Is(err, common.ErrInvalidItem) {
+		t.Errorf("expected %v, got %v", common.ErrInvalidItem, err)
+	}
+}
@@ -0,0 +1,52 @@
+package graph
+
+import "slices"
+
+// ShortestPathTree is the result of a single-source shortest path search.
+// Distances holds the cost of the cheapest known path from Source to every reached node,
+// and Predecessors holds the node preceding each reached node on that path.
+// The source has a distance of zero and no predecessor.
+type ShortestPathTree[T comparable, W Weight] struct {
+	Source       T
+	Distances    map[T]W
+	Predecessors map[T]T
+}
+
+// NewShortestPathTree creates an empty tree rooted at source.
+// The source itself is not added; searches add it once they know it is part of the graph.
+func NewShortestPathTree[T comparable, W Weight](source T) *ShortestPathTree[T, W] {
+	return &ShortestPathTree[T, W]{
+		Source:       source,
+		Distances:    make(map[T]W),
+		Predecessors: make(map[T]T),
+	}
+}
+
+// DistanceTo returns the cost of the cheapest path from the source to dst.
+// The boolean result reports whether dst was reached.
+func (t *ShortestPathTree[T, W]) DistanceTo(dst T) (W, bool) {
+	distance, reached := t.Distances[dst]
+	return distance, reached
+}
+
+// PathTo returns the nodes on the cheapest path from the source to dst, both included.
+// The boolean result reports whether dst was reached; if it wasn't, the path is nil.
+func (t *ShortestPathTree[T, W]) PathTo(dst T) ([]T, bool) {
+	if _, reached := t.Distances[dst]; !reached {
+		return nil, false
+	}
+
+	path := []T{dst}
+	for node := dst; node != t.Source; {
+		predecessor, exists := t.Predecessors[node]
+		// A path can't be longer than the number of reached nodes unless the predecessors form a cycle
+		if !exists || len(path) > len(t.Distances) {
+			return nil, false
+		}
+		path = append(path, predecessor)
+		node = predecessor
+	}
+
+	slices.Reverse(path)
+	return path, true
+}
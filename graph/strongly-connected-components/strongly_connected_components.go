@@ -0,0 +1,175 @@
+package strongly_connected_components
+
+import (
+	"cmp"
+	"slices"
+)
+
+// Tarjan returns the strongly connected components of a directed graph using Tarjan's algorithm,
+// which finds them all in a single depth-first search.
+// Every node of a component can reach every other node of the same component.
+// Nodes within a component are sorted in ascending order, and components are returned in reverse
+// topological order: no component has an edge to a component that comes after it.
+// The graph (g) is represented as an adjacency list, such as the one built by
+// graph.GenerateGraphFromEdges with graph.Directional.
+func Tarjan[T cmp.Ordered](g map[T][]T) [][]T {
+	index := make(map[T]int)
+	lowLink := make(map[T]int)
+	onStack := make(map[T]bool)
+	stack := make([]T, 0)
+	components := make([][]T, 0)
+
+	var visit func(node T)
+	visit = func(node T) {
+		index[node] = len(index)
+		lowLink[node] = index[node]
+		stack = append(stack, node)
+		onStack[node] = true
+
+		for _, neighbor := range g[node] {
+			if _, visited := index[neighbor]; !visited {
+				visit(neighbor)
+				lowLink[node] = min(lowLink[node], lowLink[neighbor])
+			} else if onStack[neighbor] {
+				lowLink[node] = min(lowLink[node], index[neighbor])
+			}
+		}
+
+		// A node that can't reach anything visited before it is the root of a component,
+		// made of the nodes above it on the stack.
+		if lowLink[node] == index[node] {
+			start := slices.Index(stack, node)
+			component := slices.Clone(stack[start:])
+			for _, member := range component {
+				onStack[member] = false
+			}
+			stack = stack[:start]
+
+			slices.Sort(component)
+			components = append(components, component)
+		}
+	}
+
+	for _, node := range sortedNodes(g) {
+		if _, visited := index[node]; !visited {
+			visit(node)
+		}
+	}
+	return components
+}
+
+// Kosaraju returns the same components as Tarjan using Kosaraju's algorithm, which runs one depth-first search
+// on the graph to order the nodes by finish time and a second one on the transposed graph to collect the components.
+// Nodes within a component are sorted in ascending order, and components are returned in reverse topological order.
+func Kosaraju[T cmp.Ordered](g map[T][]T) [][]T {
+	nodes := sortedNodes(g)
+
+	visited := make(map[T]bool, len(nodes))
+	finished := make([]T, 0, len(nodes))
+	var order func(node T)
+	order = func(node T) {
+		visited[node] = true
+		for _, neighbor := range g[node] {
+			if !visited[neighbor] {
+				order(neighbor)
+			}
+		}
+		finished = append(finished, node)
+	}
+	for _, node := range nodes {
+		if !visited[node] {
+			order(node)
+		}
+	}
+
+	transposed := make(map[T][]T, len(nodes))
+	for _, node := range nodes {
+		for _, neighbor := range g[node] {
+			transposed[neighbor] = append(transposed[neighbor], node)
+		}
+	}
+
+	// Taking nodes by decreasing finish time, each search on the transposed graph stays inside one component
+	// and the components come out in topological order.
+	assigned := make(map[T]bool, len(nodes))
+	components := make([][]T, 0)
+	var collect func(node T, component []T) []T
+	collect = func(node T, component []T) []T {
+		assigned[node] = true
+		component = append(component, node)
+		for _, neighbor := range transposed[node] {
+			if !assigned[neighbor] {
+				component = collect(neighbor, component)
+			}
+		}
+		return component
+	}
+	for i := len(finished) - 1; i >= 0; i-- {
+		if !assigned[finished[i]] {
+			component := collect(finished[i], make([]T, 0))
+			slices.Sort(component)
+			components = append(components, component)
+		}
+	}
+
+	slices.Reverse(components)
+	return components
+}
+
+// CondensedGraph is a directed graph whose nodes are the strongly connected components of another graph.
+// It is always acyclic.
+type CondensedGraph[T comparable] struct {
+	// Components holds the strongly connected components in reverse topological order, as returned by Tarjan.
+	Components [][]T
+	// ComponentOf maps every node of the original graph to the index of its component in Components.
+	ComponentOf map[T]int
+	// DAG is the adjacency list of the components, keyed by their index. Every component has an entry,
+	// neighbors are listed once in ascending order, and every edge points to a lower index.
+	DAG map[int][]int
+}
+
+// Condensation collapses every strongly connected component of a directed graph into a single node.
+// There is an edge between two components when any node of the first has an edge to a node of the second.
+func Condensation[T cmp.Ordered](g map[T][]T) *CondensedGraph[T] {
+	condensed := &CondensedGraph[T]{
+		Components:  Tarjan(g),
+		ComponentOf: make(map[T]int),
+		DAG:         make(map[int][]int),
+	}
+	for id, component := range condensed.Components {
+		condensed.DAG[id] = make([]int, 0)
+		for _, node := range component {
+			condensed.ComponentOf[node] = id
+		}
+	}
+
+	for node, neighbors := range g {
+		from := condensed.ComponentOf[node]
+		for _, neighbor := range neighbors {
+			if to := condensed.ComponentOf[neighbor]; to != from && !slices.Contains(condensed.DAG[from], to) {
+				condensed.DAG[from] = append(condensed.DAG[from], to)
+			}
+		}
+	}
+	for id := range condensed.DAG {
+		slices.Sort(condensed.DAG[id])
+	}
+
+	return condensed
+}
+
+// sortedNodes returns every node of g, including nodes that only appear as neighbors, in ascending order.
+func sortedNodes[T cmp.Ordered](g map[T][]T) []T {
+	nodes := make([]T, 0, len(g))
+	seen := make(map[T]bool, len(g))
+	for node, neighbors := range g {
+		for _, n := range append([]T{node}, neighbors...) {
+			if !seen[n] {
+				seen[n] = true
+				nodes = append(nodes, n)
+			}
+		}
+	}
+	slices.Sort(nodes)
+	return nodes
+}
@@ -0,0 +1,149 @@
+package strongly_connected_components
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/sosalejandro/algo-practice/graph"
+)
+
+var algorithms = map[string]func(g map[string][]string) [][]string{
+	"Tarjan":   Tarjan[string],
+	"Kosaraju": Kosaraju[string],
+}
+
+func TestStronglyConnectedComponents(t *testing.T) {
+	tests := []struct {
+		name     string
+		edges    [][]string
+		expected [][]string
+	}{
+		{
+			name:     "Empty Graph",
+			edges:    [][]string{},
+			expected: [][]string{},
+		},
+		{
+			name:     "Chain",
+			edges:    [][]string{{"A", "B"}, {"B", "C"}},
+			expected: [][]string{{"A"}, {"B"}, {"C"}},
+		},
+		{
+			name:     "Single Cycle",
+			edges:    [][]string{{"A", "B"}, {"B", "C"}, {"C", "A"}},
+			expected: [][]string{{"A", "B", "C"}},
+		},
+		{
+			name: "Cycles Linked One Way",
+			edges: [][]string{
+				{"A", "B"}, {"B", "C"}, {"C", "A"},
+				{"C", "D"},
+				{"D", "E"}, {"E", "D"},
+				{"E", "F"}, {"G", "F"}, {"F", "H"}, {"H", "G"},
+			},
+			expected: [][]string{{"A", "B", "C"}, {"D", "E"}, {"F", "G", "H"}},
+		},
+		{
+			name:     "Self Loop",
+			edges:    [][]string{{"A", "A"}, {"A", "B"}},
+			expected: [][]string{{"A"}, {"B"}},
+		},
+	}
+
+	for name, algorithm := range algorithms {
+		for _, tt := range tests {
+			t.Run(name+"/"+tt.name, func(t *testing.T) {
+				g := graph.GenerateGraphFromEdges(tt.edges, graph.Directional)
+
+				components := algorithm(g)
+				if !sameComponents(components, tt.expected) {
+					t.Errorf("expected components %v, got %v", tt.expected, components)
+				}
+				if !isReverseTopological(g, components) {
+					t.Errorf("expected %v to be in reverse topological order", components)
+				}
+			})
+		}
+	}
+}
+
+func TestStronglyConnectedComponents_Deterministic(t *testing.T) {
+	g := graph.GenerateGraphFromEdges([][]string{{"A", "B"}, {"B", "A"}, {"B", "C"}, {"D", "C"}, {"E", "F"}, {"F", "E"}}, graph.Directional)
+
+	for name, algorithm := range algorithms {
+		expected := algorithm(g)
+		for range 20 {
+			if got := algorithm(g); !reflect.DeepEqual(got, expected) {
+				t.Fatalf("%s: expected every run to return %v, got %v", name, expected, got)
+			}
+		}
+	}
+}
+
+func TestCondensation(t *testing.T) {
+	g := graph.GenerateGraphFromEdges([][]string{
+		{"A", "B"}, {"B", "A"},
+		{"A", "C"}, {"B", "C"},
+		{"C", "D"}, {"D", "C"},
+		{"B", "E"}, {"D", "E"},
+	}, graph.Directional)
+
+	condensed := Condensation(g)
+
+	if len(condensed.Components) != 3 {
+		t.Fatalf("expected 3 components, got %v", condensed.Components)
+	}
+	ab, cd, e := condensed.ComponentOf["A"], condensed.ComponentOf["C"], condensed.ComponentOf["E"]
+	if condensed.ComponentOf["B"] != ab || condensed.ComponentOf["D"] != cd {
+		t.Errorf("expected A and B, and C and D, to share components, got %v", condensed.ComponentOf)
+	}
+
+	expected := map[int][]int{
+		ab: {cd, e},
+		cd: {e},
+		e:  {},
+	}
+	slices.Sort(expected[ab])
+	if !reflect.DeepEqual(condensed.DAG, expected) {
+		t.Errorf("expected DAG %v, got %v", expected, condensed.DAG)
+	}
+	for from, neighbors := range condensed.DAG {
+		for _, to := range neighbors {
+			if to >= from {
+				t.Errorf("expected edge %d -> %d to point to a lower index", from, to)
+			}
+		}
+	}
+}
+
+// sameComponents checks if got and expected hold the same components, in any order.
+func sameComponents(got, expected [][]string) bool {
+	if len(got) != len(expected) {
+		return false
+	}
+	for _, component := range expected {
+		if !slices.ContainsFunc(got, func(c []string) bool { return slices.Equal(c, component) }) {
+			return false
+		}
+	}
+	return true
+}
+
+// isReverseTopological checks that no edge of g leads from a component to one listed after it.
+func isReverseTopological(g map[string][]string, components [][]string) bool {
+	position := make(map[string]int)
+	for i, component := range components {
+		for _, node := range component {
+			position[node] = i
+		}
+	}
+	for node, neighbors := range g {
+		for _, neighbor := range neighbors {
+			if position[neighbor] > position[node] {
+				return false
+			}
+		}
+	}
+	return true
+}
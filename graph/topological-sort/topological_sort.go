@@ -0,0 +1,206 @@
+package topological_sort
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+
+	priority_queue "github.com/sosalejandro/algo-practice/data-structures/priority-queue"
+)
+
+// CycleError is returned when a graph that must be acyclic contains a cycle.
+// Cycle lists the nodes of one cycle in edge order, starting from its smallest node,
+// without repeating that node at the end.
+type CycleError[T comparable] struct {
+	Cycle []T
+}
+
+func (e *CycleError[T]) Error() string {
+	nodes := make([]string, 0, len(e.Cycle)+1)
+	for _, node := range e.Cycle {
+		nodes = append(nodes, fmt.Sprint(node))
+	}
+	if len(e.Cycle) > 0 {
+		nodes = append(nodes, fmt.Sprint(e.Cycle[0]))
+	}
+	return "graph has a cycle: " + strings.Join(nodes, " -> ")
+}
+
+// Kahn returns the nodes of a directed graph in an order where every node comes before the nodes it points to,
+// using Kahn's algorithm. When several nodes are ready at once the smallest goes first,
+// so the result is the lexicographically smallest topological order.
+// The graph (g) is represented as an adjacency list, such as the one built by
+// graph.GenerateGraphFromEdges with graph.Directional.
+// It returns a *CycleError if the graph has a cycle.
+func Kahn[T cmp.Ordered](g map[T][]T) ([]T, error) {
+	inDegrees := inDegrees(g)
+
+	ready := priority_queue.NewPriorityQueue[T, T]()
+	for node, inDegree := range inDegrees {
+		if inDegree == 0 {
+			ready.Push(node, node)
+		}
+	}
+
+	order := make([]T, 0, len(inDegrees))
+	for !ready.IsEmpty() {
+		current, err := ready.Pop()
+		if err != nil {
+			return nil, err
+		}
+		node := current.Value()
+		order = append(order, node)
+
+		for _, neighbor := range g[node] {
+			inDegrees[neighbor]--
+			if inDegrees[neighbor] == 0 {
+				ready.Push(neighbor, neighbor)
+			}
+		}
+	}
+
+	// Nodes on a cycle never run out of incoming edges
+	if len(order) < len(inDegrees) {
+		return nil, &CycleError[T]{Cycle: findCycle(g)}
+	}
+	return order, nil
+}
+
+// DFS returns the nodes of a directed graph in an order where every node comes before the nodes it points to,
+// using the reverse post-order of a depth-first search started from the nodes in ascending order.
+// It returns a *CycleError if the graph has a cycle.
+func DFS[T cmp.Ordered](g map[T][]T) ([]T, error) {
+	order, cycle := depthFirst(g)
+	if cycle != nil {
+		return nil, &CycleError[T]{Cycle: cycle}
+	}
+	return order, nil
+}
+
+// IsDAG checks if a directed graph has no cycles.
+func IsDAG[T cmp.Ordered](g map[T][]T) bool {
+	_, cycle := depthFirst(g)
+	return cycle == nil
+}
+
+// Layers groups the nodes of a directed graph so that every node only points to nodes in later layers.
+// The first layer holds the nodes without incoming edges and every other layer the nodes whose incoming edges
+// all come from earlier layers, so the nodes of a layer can run in parallel once the previous layers are done.
+// Nodes within a layer are sorted in ascending order.
+// It returns a *CycleError if the graph has a cycle.
+func Layers[T cmp.Ordered](g map[T][]T) ([][]T, error) {
+	inDegrees := inDegrees(g)
+
+	layer := make([]T, 0)
+	for node, inDegree := range inDegrees {
+		if inDegree == 0 {
+			layer = append(layer, node)
+		}
+	}
+
+	layers := make([][]T, 0)
+	placed := 0
+	for len(layer) > 0 {
+		slices.Sort(layer)
+		layers = append(layers, layer)
+		placed += len(layer)
+
+		next := make([]T, 0)
+		for _, node := range layer {
+			for _, neighbor := range g[node] {
+				inDegrees[neighbor]--
+				if inDegrees[neighbor] == 0 {
+					next = append(next, neighbor)
+				}
+			}
+		}
+		layer = next
+	}
+
+	if placed < len(inDegrees) {
+		return nil, &CycleError[T]{Cycle: findCycle(g)}
+	}
+	return layers, nil
+}
+
+// inDegrees returns the number of incoming edges of every node, including nodes that only appear as neighbors.
+func inDegrees[T comparable](g map[T][]T) map[T]int {
+	result := make(map[T]int, len(g))
+	for node, neighbors := range g {
+		if _, exists := result[node]; !exists {
+			result[node] = 0
+		}
+		for _, neighbor := range neighbors {
+			result[neighbor]++
+		}
+	}
+	return result
+}
+
+// findCycle returns a cycle of a graph known to have one.
+func findCycle[T cmp.Ordered](g map[T][]T) []T {
+	_, cycle := depthFirst(g)
+	return cycle
+}
+
+// Colors used by depthFirst to track the state of every node.
+const (
+	white = iota // not visited yet
+	gray         // on the current path
+	black        // finished
+)
+
+// depthFirst runs a depth-first search over every node in ascending order.
+// It returns the reverse post-order of the nodes, or the first cycle found,
+// which is an edge to a node still on the current path.
+func depthFirst[T cmp.Ordered](g map[T][]T) ([]T, []T) {
+	nodes := make([]T, 0, len(g))
+	for node := range inDegrees(g) {
+		nodes = append(nodes, node)
+	}
+	slices.Sort(nodes)
+
+	colors := make(map[T]int, len(nodes))
+	path := make([]T, 0)
+	order := make([]T, 0, len(nodes))
+
+	var visit func(node T) []T
+	visit = func(node T) []T {
+		colors[node] = gray
+		path = append(path, node)
+
+		for _, neighbor := range g[node] {
+			switch colors[neighbor] {
+			case gray:
+				return rotateToMin(path[slices.Index(path, neighbor):])
+			case white:
+				if cycle := visit(neighbor); cycle != nil {
+					return cycle
+				}
+			}
+		}
+
+		colors[node] = black
+		path = path[:len(path)-1]
+		order = append(order, node)
+		return nil
+	}
+
+	for _, node := range nodes {
+		if colors[node] == white {
+			if cycle := visit(node); cycle != nil {
+				return nil, cycle
+			}
+		}
+	}
+
+	slices.Reverse(order)
+	return order, nil
+}
+
+// rotateToMin returns a copy of cycle rotated to start from its smallest node.
+func rotateToMin[T cmp.Ordered](cycle []T) []T {
+	start := slices.Index(cycle, slices.Min(cycle))
+	return slices.Concat(cycle[start:], cycle[:start])
+}
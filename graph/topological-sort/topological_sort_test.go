@@ -0,0 +1,193 @@
+package topological_sort
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/sosalejandro/algo-practice/graph"
+)
+
+type sortFunc func(g map[string][]string) ([]string, error)
+
+func TestTopologicalSort(t *testing.T) {
+	tests := []struct {
+		name  string
+		graph map[string][]string
+		kahn  []string
+		dfs   []string
+	}{
+		{
+			name:  "Empty Graph",
+			graph: map[string][]string{},
+			kahn:  []string{},
+			dfs:   []string{},
+		},
+		{
+			name:  "Build Dependencies",
+			graph: graph.GenerateGraphFromEdges([][]string{{"lib", "app"}, {"base", "lib"}, {"base", "tools"}, {"tools", "app"}, {"docs", "release"}, {"app", "release"}}, graph.Directional),
+			kahn:  []string{"base", "docs", "lib", "tools", "app", "release"},
+			dfs:   []string{"docs", "base", "tools", "lib", "app", "release"},
+		},
+		{
+			name:  "Disconnected Nodes",
+			graph: map[string][]string{"C": {}, "B": {}, "A": {}},
+			kahn:  []string{"A", "B", "C"},
+			dfs:   []string{"C", "B", "A"},
+		},
+		{
+			name:  "Neighbor Missing From Adjacency List",
+			graph: map[string][]string{"A": {"B"}},
+			kahn:  []string{"A", "B"},
+			dfs:   []string{"A", "B"},
+		},
+	}
+
+	for _, tt := range tests {
+		for name, run := range map[string]struct {
+			sort     sortFunc
+			expected []string
+		}{
+			"Kahn": {sort: Kahn[string], expected: tt.kahn},
+			"DFS":  {sort: DFS[string], expected: tt.dfs},
+		} {
+			t.Run(name+"/"+tt.name, func(t *testing.T) {
+				order, err := run.sort(tt.graph)
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if !reflect.DeepEqual(order, run.expected) {
+					t.Errorf("expected %v, got %v", run.expected, order)
+				}
+				if !isTopologicalOrder(tt.graph, order) {
+					t.Errorf("expected %v to respect every edge", order)
+				}
+			})
+		}
+
+		t.Run("IsDAG/"+tt.name, func(t *testing.T) {
+			if !IsDAG(tt.graph) {
+				t.Errorf("expected graph to be acyclic")
+			}
+		})
+	}
+}
+
+func TestTopologicalSort_Cycle(t *testing.T) {
+	tests := []struct {
+		name  string
+		graph map[string][]string
+		cycle []string
+	}{
+		{
+			name:  "Self Loop",
+			graph: graph.GenerateGraphFromEdges([][]string{{"A", "B"}, {"B", "B"}}, graph.Directional),
+			cycle: []string{"B"},
+		},
+		{
+			name:  "Cycle Behind Acyclic Prefix",
+			graph: graph.GenerateGraphFromEdges([][]string{{"A", "B"}, {"B", "D"}, {"D", "C"}, {"C", "B"}, {"C", "E"}}, graph.Directional),
+			cycle: []string{"B", "D", "C"},
+		},
+		{
+			name:  "Two Node Cycle",
+			graph: graph.GenerateGraphFromEdges([][]string{{"Y", "X"}, {"X", "Y"}}, graph.Directional),
+			cycle: []string{"X", "Y"},
+		},
+	}
+
+	for _, tt := range tests {
+		for name, sort := range map[string]sortFunc{"Kahn": Kahn[string], "DFS": DFS[string]} {
+			t.Run(name+"/"+tt.name, func(t *testing.T) {
+				_, err := sort(tt.graph)
+
+				var cycleErr *CycleError[string]
+				if !errors.As(err, &cycleErr) {
+					t.Fatalf("expected a CycleError, got %v", err)
+				}
+				if !reflect.DeepEqual(cycleErr.Cycle, tt.cycle) {
+					t.Errorf("expected cycle %v, got %v", tt.cycle, cycleErr.Cycle)
+				}
+			})
+		}
+
+		t.Run("Layers/"+tt.name, func(t *testing.T) {
+			_, err := Layers(tt.graph)
+
+			var cycleErr *CycleError[string]
+			if !errors.As(err, &cycleErr) {
+				t.Fatalf("expected a CycleError, got %v", err)
+			}
+		})
+
+		t.Run("IsDAG/"+tt.name, func(t *testing.T) {
+			if IsDAG(tt.graph) {
+				t.Errorf("expected graph to have a cycle")
+			}
+		})
+	}
+}
+
+func TestCycleError_Error(t *testing.T) {
+	err := &CycleError[string]{Cycle: []string{"A", "B", "C"}}
+	if expected := "graph has a cycle: A -> B -> C -> A"; err.Error() != expected {
+		t.Errorf("expected %q, got %q", expected, err.Error())
+	}
+}
+
+func TestLayers(t *testing.T) {
+	tests := []struct {
+		name     string
+		graph    map[string][]string
+		expected [][]string
+	}{
+		{
+			name:     "Empty Graph",
+			graph:    map[string][]string{},
+			expected: [][]string{},
+		},
+		{
+			name:     "Build Dependencies",
+			graph:    graph.GenerateGraphFromEdges([][]string{{"lib", "app"}, {"base", "lib"}, {"base", "tools"}, {"tools", "app"}, {"docs", "release"}, {"app", "release"}}, graph.Directional),
+			expected: [][]string{{"base", "docs"}, {"lib", "tools"}, {"app"}, {"release"}},
+		},
+		{
+			name:     "Longest Path Decides The Layer",
+			graph:    graph.GenerateGraphFromEdges([][]string{{"A", "D"}, {"A", "B"}, {"B", "C"}, {"C", "D"}}, graph.Directional),
+			expected: [][]string{{"A"}, {"B"}, {"C"}, {"D"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			layers, err := Layers(tt.graph)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(layers, tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, layers)
+			}
+		})
+	}
+}
+
+// isTopologicalOrder checks that order holds every node of g once and that every edge points forward.
+func isTopologicalOrder(g map[string][]string, order []string) bool {
+	position := make(map[string]int, len(order))
+	for i, node := range order {
+		position[node] = i
+	}
+	if len(position) != len(order) {
+		return false
+	}
+	for node, neighbors := range g {
+		for _, neighbor := range neighbors {
+			from, fromExists := position[node]
+			to, toExists := position[neighbor]
+			if !fromExists || !toExists || from >= to {
+				return false
+			}
+		}
+	}
+	return true
+}
@@ -0,0 +1,140 @@
+package graph
+
+// Weight is the set of numeric types that can be used as edge weights.
+type Weight interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 |
+		~float32 | ~float64
+}
+
+// WeightedEdge is an edge from Src to Dst carrying a Weight.
+type WeightedEdge[T comparable, W Weight] struct {
+	Src    T
+	Dst    T
+	Weight W
+}
+
+// Neighbor is a node adjacent to another one, together with the weight of the edge leading to it.
+type Neighbor[T comparable, W Weight] struct {
+	Node   T
+	Weight W
+}
+
+// WeightedGraph is an adjacency list whose edges carry weights.
+// Like GenerateGraphFromEdges, a Bidirectional graph stores every edge in both directions.
+// Nodes and edges are kept in insertion order so that algorithms iterating over them are deterministic.
+type WeightedGraph[T comparable, W Weight] struct {
+	graphType GraphType
+	adjacency map[T][]Neighbor[T, W]
+	nodes     []T
+	edges     []WeightedEdge[T, W]
+}
+
+// NewWeightedGraph creates an empty weighted graph of the given type.
+func NewWeightedGraph[T comparable, W Weight](graphType GraphType) *WeightedGraph[T, W] {
+	return &WeightedGraph[T, W]{
+		graphType: graphType,
+		adjacency: make(map[T][]Neighbor[T, W]),
+		nodes:     make([]T, 0),
+		edges:     make([]WeightedEdge[T, W], 0),
+	}
+}
+
+// GenerateWeightedGraphFromEdges creates a weighted graph from a list of (src, dst, weight) edges.
+// Every node mentioned by an edge is added to the graph, even if it has no outgoing edges.
+func GenerateWeightedGraphFromEdges[T comparable, W Weight](edges []WeightedEdge[T, W], graphType GraphType) *WeightedGraph[T, W] {
+	g := NewWeightedGraph[T, W](graphType)
+	for _, edge := range edges {
+		g.AddEdge(edge.Src, edge.Dst, edge.Weight)
+	}
+	return g
+}
+
+// Type returns whether the graph is Directional or Bidirectional.
+func (g *WeightedGraph[T, W]) Type() GraphType {
+	return g.graphType
+}
+
+// AddNode adds a node without edges. Adding an existing node has no effect.
+func (g *WeightedGraph[T, W]) AddNode(node T) {
+	if _, exists := g.adjacency[node]; exists {
+		return
+	}
+	g.adjacency[node] = make([]Neighbor[T, W], 0)
+	g.nodes = append(g.nodes, node)
+}
+
+// AddEdge adds an edge from src to dst, adding either node if it doesn't yet exist.
+// In a Bidirectional graph the reverse edge is added as well.
+func (g *WeightedGraph[T, W]) AddEdge(src, dst T, weight W) {
+	g.AddNode(src)
+	g.AddNode(dst)
+
+	g.adjacency[src] = append(g.adjacency[src], Neighbor[T, W]{Node: dst, Weight: weight})
+	if g.graphType == Bidirectional {
+		g.adjacency[dst] = append(g.adjacency[dst], Neighbor[T, W]{Node: src, Weight: weight})
+	}
+	g.edges = append(g.edges, WeightedEdge[T, W]{Src: src, Dst: dst, Weight: weight})
+}
+
+// HasNode checks if node exists in the graph.
+func (g *WeightedGraph[T, W]) HasNode(node T) bool {
+	_, exists := g.adjacency[node]
+	return exists
+}
+
+// Neighbors returns the nodes reachable from node through a single edge, with the weight of that edge.
+// The returned slice is owned by the graph and must not be modified.
+func (g *WeightedGraph[T, W]) Neighbors(node T) []Neighbor[T, W] {
+	return g.adjacency[node]
+}
+
+// Weight returns the weight of the first edge from src to dst.
+// The boolean result reports whether such an edge exists.
+func (g *WeightedGraph[T, W]) Weight(src, dst T) (W, bool) {
+	for _, neighbor := range g.adjacency[src] {
+		if neighbor.Node == dst {
+			return neighbor.Weight, true
+		}
+	}
+	var zero W
+	return zero, false
+}
+
+// Nodes returns every node of the graph in insertion order.
+func (g *WeightedGraph[T, W]) Nodes() []T {
+	result := make([]T, len(g.nodes))
+	copy(result, g.nodes)
+	return result
+}
+
+// Edges returns every edge of the graph in insertion order.
+// Edges of a Bidirectional graph are listed once, in the direction they were added.
+func (g *WeightedGraph[T, W]) Edges() []WeightedEdge[T, W] {
+	result := make([]WeightedEdge[T, W], len(g.edges))
+	copy(result, g.edges)
+	return result
+}
+
+// NodeCount returns the number of nodes in the graph.
+func (g *WeightedGraph[T, W]) NodeCount() int {
+	return len(g.nodes)
+}
+
+// EdgeCount returns the number of edges added to the graph.
+func (g *WeightedGraph[T, W]) EdgeCount() int {
+	return len(g.edges)
+}
+
+// AdjacencyList returns the graph without weights, in the same shape GenerateGraphFromEdges produces,
+// so it can be passed to the unweighted algorithms.
+func (g *WeightedGraph[T, W]) AdjacencyList() map[T][]T {
+	result := make(map[T][]T, len(g.adjacency))
+	for node, neighbors := range g.adjacency {
+		result[node] = make([]T, 0, len(neighbors))
+		for _, neighbor := range neighbors {
+			result[node] = append(result[node], neighbor.Node)
+		}
+	}
+	return result
+}
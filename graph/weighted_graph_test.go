@@ -0,0 +1,134 @@
+package graph_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/sosalejandro/algo-practice/graph"
+)
+
+func TestGenerateWeightedGraphFromEdges(t *testing.T) {
+	tests := []struct {
+		name      string
+		graphType graph.GraphType
+		edges     []graph.WeightedEdge[string, int]
+		expected  map[string][]graph.Neighbor[string, int]
+	}{
+		{
+			name:      "Directional: Empty Edges",
+			graphType: graph.Directional,
+			edges:     []graph.WeightedEdge[string, int]{},
+			expected:  map[string][]graph.Neighbor[string, int]{},
+		},
+		{
+			name:      "Directional: Multiple Edges",
+			graphType: graph.Directional,
+			edges: []graph.WeightedEdge[string, int]{
+				{Src: "A", Dst: "B", Weight: 4},
+				{Src: "A", Dst: "C", Weight: 1},
+				{Src: "C", Dst: "B", Weight: 2},
+			},
+			expected: map[string][]graph.Neighbor[string, int]{
+				"A": {{Node: "B", Weight: 4}, {Node: "C", Weight: 1}},
+				"B": {},
+				"C": {{Node: "B", Weight: 2}},
+			},
+		},
+		{
+			name:      "Bidirectional: Multiple Edges",
+			graphType: graph.Bidirectional,
+			edges: []graph.WeightedEdge[string, int]{
+				{Src: "A", Dst: "B", Weight: 4},
+				{Src: "B", Dst: "C", Weight: 7},
+			},
+			expected: map[string][]graph.Neighbor[string, int]{
+				"A": {{Node: "B", Weight: 4}},
+				"B": {{Node: "A", Weight: 4}, {Node: "C", Weight: 7}},
+				"C": {{Node: "B", Weight: 7}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := graph.GenerateWeightedGraphFromEdges(tt.edges, tt.graphType)
+
+			if g.Type() != tt.graphType {
+				t.Errorf("expected graph type %v, got %v", tt.graphType, g.Type())
+			}
+			if g.NodeCount() != len(tt.expected) {
+				t.Errorf("expected %d nodes, got %d", len(tt.expected), g.NodeCount())
+			}
+			if g.EdgeCount() != len(tt.edges) {
+				t.Errorf("expected %d edges, got %d", len(tt.edges), g.EdgeCount())
+			}
+			for node, neighbors := range tt.expected {
+				if !g.HasNode(node) {
+					t.Errorf("expected node %v to exist", node)
+				}
+				if got := g.Neighbors(node); !reflect.DeepEqual(got, neighbors) {
+					t.Errorf("expected neighbors of %v to be %v, got %v", node, neighbors, got)
+				}
+			}
+		})
+	}
+}
+
+func TestWeightedGraph_Weight(t *testing.T) {
+	g := graph.GenerateWeightedGraphFromEdges([]graph.WeightedEdge[string, float64]{
+		{Src: "A", Dst: "B", Weight: 1.5},
+	}, graph.Bidirectional)
+
+	if w, ok := g.Weight("A", "B"); !ok || w != 1.5 {
+		t.Errorf("expected weight 1.5, got %v (found %v)", w, ok)
+	}
+	if w, ok := g.Weight("B", "A"); !ok || w != 1.5 {
+		t.Errorf("expected reverse weight 1.5, got %v (found %v)", w, ok)
+	}
+	if _, ok := g.Weight("A", "C"); ok {
+		t.Errorf("expected no edge between A and C")
+	}
+}
+
+func TestWeightedGraph_NodesAndEdges(t *testing.T) {
+	g := graph.NewWeightedGraph[string, int](graph.Bidirectional)
+	g.AddNode("Z")
+	g.AddEdge("A", "B", 3)
+	g.AddEdge("B", "C", 5)
+	g.AddNode("A")
+
+	if got, want := g.Nodes(), []string{"Z", "A", "B", "C"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("expected nodes %v, got %v", want, got)
+	}
+
+	want := []graph.WeightedEdge[string, int]{
+		{Src: "A", Dst: "B", Weight: 3},
+		{Src: "B", Dst: "C", Weight: 5},
+	}
+	edges := g.Edges()
+	if !reflect.DeepEqual(edges, want) {
+		t.Errorf("expected edges %v, got %v", want, edges)
+	}
+
+	edges[0].Weight = 100
+	if w, _ := g.Weight("A", "B"); w != 3 {
+		t.Errorf("expected Edges to return a copy, weight changed to %v", w)
+	}
+}
+
+func TestWeightedGraph_AdjacencyList(t *testing.T) {
+	edges := []graph.WeightedEdge[string, int]{
+		{Src: "A", Dst: "B", Weight: 1},
+		{Src: "A", Dst: "C", Weight: 2},
+		{Src: "B", Dst: "D", Weight: 3},
+	}
+	pairs := [][]string{{"A", "B"}, {"A", "C"}, {"B", "D"}}
+
+	for _, graphType := range []graph.GraphType{graph.Directional, graph.Bidirectional} {
+		got := graph.GenerateWeightedGraphFromEdges(edges, graphType).AdjacencyList()
+		want := graph.GenerateGraphFromEdges(pairs, graphType)
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("expected %v, got %v", want, got)
+		}
+	}
+}